@@ -0,0 +1,92 @@
+package boolable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Bool is a bool that decodes leniently from JSON, text, YAML and SQL, accepting
+// anything Parse does: true and false as booleans, the strings true/t/yes/y/on/1
+// and false/f/no/n/off/0 in any case, and the numbers 1 and 0. It always encodes
+// as a plain boolean.
+type Bool bool
+
+// MarshalJSON encodes b as a JSON boolean.
+func (b Bool) MarshalJSON() ([]byte, error) {
+	return json.Marshal(bool(b))
+}
+
+// UnmarshalJSON decodes a JSON boolean, string or number. Null leaves b unchanged.
+func (b *Bool) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	if value == nil {
+		return nil
+	}
+	return b.set(value)
+}
+
+// MarshalText encodes b as "true" or "false".
+func (b Bool) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText decodes text with Parse. Empty text is false, so an empty environment
+// variable binds like a plain bool.
+func (b *Bool) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = false
+		return nil
+	}
+	return b.set(string(text))
+}
+
+// UnmarshalYAML decodes a YAML scalar, including the YAML 1.1 forms yes/no/on/off.
+// It implements the Unmarshaler interface of gopkg.in/yaml.v2, which yaml.v3 also honours.
+func (b *Bool) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	if value == nil {
+		return nil
+	}
+	return b.set(value)
+}
+
+// Scan implements sql.Scanner, accepting booleans, numbers, strings and bytes.
+// NULL scans as false.
+func (b *Bool) Scan(src interface{}) error {
+	if raw, ok := src.([]byte); ok {
+		src = string(raw)
+	}
+	return b.set(src)
+}
+
+// Value implements driver.Valuer.
+func (b Bool) Value() (driver.Value, error) {
+	return bool(b), nil
+}
+
+// String returns "true" or "false".
+func (b Bool) String() string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+func (b *Bool) set(value interface{}) error {
+	result, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*b = Bool(result)
+	return nil
+}
@@ -0,0 +1,125 @@
+package boolable_test
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sampson-golang/utilities/boolable"
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestBool(t *testing.T) {
+	t.Run("UnmarshalJSON", func(t *testing.T) {
+		cases := map[string]boolable.Bool{
+			`true`: true, `false`: false, `"true"`: true, `"yes"`: true, `"ON"`: true,
+			`"off"`: false, `1`: true, `0`: false, `"1"`: true, `"0"`: false, `1.0`: true,
+		}
+		for input, expected := range cases {
+			var payload struct {
+				Enabled boolable.Bool `json:"enabled"`
+			}
+			if err := json.Unmarshal([]byte(`{"enabled":`+input+`}`), &payload); err != nil || payload.Enabled != expected {
+				t.Errorf("Unmarshal(%s) = %v, %v, expected %v, nil", input, payload.Enabled, err, expected)
+			}
+		}
+
+		for _, input := range []string{`"maybe"`, `""`, `2`, `[]`, `{}`} {
+			var value boolable.Bool
+			if err := json.Unmarshal([]byte(input), &value); !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Unmarshal(%s) error = %v, expected ErrInvalid", input, err)
+			}
+		}
+
+		value := boolable.Bool(true)
+		if err := json.Unmarshal([]byte(`null`), &value); err != nil || value != true {
+			t.Errorf("Unmarshal(null) = %v, %v, expected true, nil", value, err)
+		}
+	})
+
+	t.Run("MarshalJSON", func(t *testing.T) {
+		data, err := json.Marshal(map[string]boolable.Bool{"on": true, "off": false})
+		if err != nil || string(data) != `{"off":false,"on":true}` {
+			t.Errorf("Marshal = %s, %v", data, err)
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var value boolable.Bool
+		if err := value.UnmarshalText([]byte("Yes")); err != nil || value != true {
+			t.Errorf("UnmarshalText(Yes) = %v, %v, expected true, nil", value, err)
+		}
+		if err := value.UnmarshalText([]byte("ture")); !errors.Is(err, boolable.ErrInvalid) || value != true {
+			t.Errorf("UnmarshalText(ture) = %v, %v, expected unchanged value and ErrInvalid", value, err)
+		}
+		if err := value.UnmarshalText(nil); err != nil || value != false {
+			t.Errorf("UnmarshalText(empty) = %v, %v, expected false, nil", value, err)
+		}
+		if text, err := boolable.Bool(false).MarshalText(); err != nil || string(text) != "false" {
+			t.Errorf("MarshalText = %s, %v, expected false", text, err)
+		}
+	})
+
+	t.Run("UnmarshalYAML", func(t *testing.T) {
+		cases := map[interface{}]boolable.Bool{"yes": true, "N": false, "on": true, true: true, 0: false}
+		for input, expected := range cases {
+			var value boolable.Bool
+			unmarshal := func(target interface{}) error {
+				*target.(*interface{}) = input
+				return nil
+			}
+			if err := value.UnmarshalYAML(unmarshal); err != nil || value != expected {
+				t.Errorf("UnmarshalYAML(%v) = %v, %v, expected %v, nil", input, value, err, expected)
+			}
+		}
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		cases := map[interface{}]boolable.Bool{
+			true: true, int64(0): false, int64(1): true, "t": true, "f": false, nil: false,
+		}
+		for src, expected := range cases {
+			value := boolable.Bool(!expected)
+			if err := value.Scan(src); err != nil || value != expected {
+				t.Errorf("Scan(%v) = %v, %v, expected %v, nil", src, value, err, expected)
+			}
+		}
+
+		var value boolable.Bool
+		if err := value.Scan([]byte("1")); err != nil || value != true {
+			t.Errorf("Scan([]byte(1)) = %v, %v, expected true, nil", value, err)
+		}
+		if err := value.Scan(int64(5)); !errors.Is(err, boolable.ErrInvalid) {
+			t.Errorf("Scan(5) error = %v, expected ErrInvalid", err)
+		}
+
+		var valuer driver.Valuer = boolable.Bool(true)
+		if result, err := valuer.Value(); result != true || err != nil {
+			t.Errorf("Value() = %v, %v, expected true, nil", result, err)
+		}
+	})
+
+	t.Run("env", func(t *testing.T) {
+		var config struct {
+			Debug boolable.Bool `env:"DEBUG"`
+		}
+		if err := env.New(env.MapSource{"DEBUG": "on"}).Bind(&config); err != nil || config.Debug != true {
+			t.Errorf("Bind = %v, %v, expected true, nil", config.Debug, err)
+		}
+
+		var empty struct {
+			Debug boolable.Bool `env:"DEBUG"`
+			Plain bool          `env:"DEBUG"`
+		}
+		if err := env.New(env.MapSource{"DEBUG": ""}).Bind(&empty); err != nil || empty.Debug != false || empty.Plain != false {
+			t.Errorf("Bind(empty) = %v, %v, %v, expected false like a plain bool", empty.Debug, empty.Plain, err)
+		}
+	})
+
+	t.Run("From", func(t *testing.T) {
+		if boolable.From(boolable.Bool(false)) != false || boolable.From(boolable.Bool(true)) != true {
+			t.Error("From(Bool) does not match the Bool value")
+		}
+	})
+}
@@ -0,0 +1,88 @@
+package boolable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+// Boolable is implemented by types that know their own truth value.
+// From and Parse call Bool instead of converting the value by kind.
+type Boolable interface {
+	Bool() bool
+}
+
+// Truthy is an alternative to Boolable for types that already have a Bool method
+// with another meaning.
+type Truthy interface {
+	Truthy() bool
+}
+
+// hasMethods reports whether values of type t have methods to check. Types without
+// methods, such as the basic kinds and unnamed collections, skip the interface checks,
+// as do interfaces, which are checked through the value they hold.
+func hasMethods(t reflect.Type) bool {
+	return t.Kind() != reflect.Interface && t.NumMethod() > 0
+}
+
+// parseMethods converts values whose truth comes from their methods rather than
+// their kind, reporting false if value has none of them. A fmt.Stringer is stored
+// in str for values with no boolean meaning of their own.
+func (p *Policy) parseMethods(value interface{}, str *fmt.Stringer) (result bool, ok bool, err error) {
+	switch v := value.(type) {
+	case reflect.Value:
+		// reflect.Value has a Bool method that panics for other kinds, so it is not
+		// Boolable: the value it holds is converted instead.
+		result, err = p.parseReflected(v)
+		return result, true, err
+	case *reflect.Value:
+		result, err = p.parseReflected(*v)
+		return result, true, err
+	case Boolable:
+		return v.Bool(), true, nil
+	case Truthy:
+		return v.Truthy(), true, nil
+	case json.Number:
+		if number, err := v.Float64(); err == nil {
+			result, err = p.parseNumber(reflect.ValueOf(v), number == 1, number == 0)
+			return result, true, err
+		}
+		result, err = p.parseString(string(v))
+		return result, true, err
+	case time.Time:
+		return !v.IsZero() || p.ZeroTrue, true, nil
+	case *big.Int:
+		result, err = p.parseNumber(reflect.ValueOf(v), v.IsInt64() && v.Int64() == 1, v.Sign() == 0)
+		return result, true, err
+	case *big.Float:
+		result, err = p.parseNumber(reflect.ValueOf(v), v.Cmp(big.NewFloat(1)) == 0, v.Sign() == 0)
+		return result, true, err
+	case *big.Rat:
+		result, err = p.parseNumber(reflect.ValueOf(v), v.Cmp(big.NewRat(1, 1)) == 0, v.Sign() == 0)
+		return result, true, err
+	case driver.Valuer:
+		driverValue, err := v.Value()
+		if err != nil {
+			return false, true, fmt.Errorf("boolable: %w: %v", ErrInvalid, err)
+		}
+		result, err = p.parse(driverValue, nil)
+		return result, true, err
+	case fmt.Stringer:
+		*str = v
+	}
+	return false, false, nil
+}
+
+// parseReflected converts the value held by a reflect.Value, which is false if invalid.
+func (p *Policy) parseReflected(value reflect.Value) (bool, error) {
+	if !value.IsValid() {
+		return false, nil
+	}
+	if value.CanInterface() {
+		return p.parse(value.Interface(), nil)
+	}
+	return p.parseValue(value, nil, false)
+}
@@ -0,0 +1,155 @@
+package boolable_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/boolable"
+)
+
+type feature struct {
+	enabled bool
+}
+
+func (f feature) Bool() bool {
+	return f.enabled
+}
+
+type toggle struct {
+	state string
+}
+
+func (t *toggle) Truthy() bool {
+	return t.state == "on"
+}
+
+type level int
+
+func (l level) Bool() bool {
+	return l > 2
+}
+
+type status struct {
+	name string
+}
+
+func (s status) String() string {
+	return s.name
+}
+
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("connection closed")
+}
+
+func TestBoolableInterfaces(t *testing.T) {
+	var nilToggle *toggle
+	reflectedZero := reflect.ValueOf(0)
+
+	cases := []struct {
+		name     string
+		value    interface{}
+		expected bool
+	}{
+		{"Boolable true", feature{true}, true},
+		{"Boolable false", feature{false}, false},
+		{"Boolable pointer", &feature{false}, false},
+		{"Boolable overrides kind", level(1), false},
+		{"Truthy pointer receiver", &toggle{"on"}, true},
+		{"Truthy false", &toggle{"off"}, false},
+		{"Truthy nil pointer", nilToggle, false},
+		{"NullBool invalid", sql.NullBool{Bool: true}, false},
+		{"NullBool false", sql.NullBool{Bool: false, Valid: true}, false},
+		{"NullBool true", sql.NullBool{Bool: true, Valid: true}, true},
+		{"NullString invalid", sql.NullString{}, false},
+		{"NullString false", sql.NullString{String: "off", Valid: true}, false},
+		{"NullString true", sql.NullString{String: "yes", Valid: true}, true},
+		{"NullInt64 zero", sql.NullInt64{Valid: true}, false},
+		{"NullInt64", sql.NullInt64{Int64: 3, Valid: true}, true},
+		{"NullFloat64 pointer", &sql.NullFloat64{Float64: 0.5, Valid: true}, true},
+		{"NullTime zero", sql.NullTime{Valid: true}, false},
+		{"NullTime", sql.NullTime{Time: time.Unix(1, 0), Valid: true}, true},
+		{"json.Number zero", json.Number("0"), false},
+		{"json.Number zero float", json.Number("0.0"), false},
+		{"json.Number", json.Number("-2.5e3"), true},
+		{"time.Time zero", time.Time{}, false},
+		{"time.Time", time.Unix(0, 0), true},
+		{"time.Time pointer", &time.Time{}, false},
+		{"big.Int zero", new(big.Int), false},
+		{"big.Int", big.NewInt(-7), true},
+		{"big.Float zero", new(big.Float), false},
+		{"big.Rat", big.NewRat(1, 3), true},
+		{"Stringer false", status{"off"}, false},
+		{"Stringer true", status{"running"}, true},
+		{"Stringer pointer", &status{"no"}, false},
+		{"valuer error", failingValuer{}, false},
+		{"reflect.Value int", reflect.ValueOf(3), true},
+		{"reflect.Value zero", reflect.ValueOf(0), false},
+		{"reflect.Value string", reflect.ValueOf("off"), false},
+		{"reflect.Value Boolable", reflect.ValueOf(feature{true}), true},
+		{"reflect.Value invalid", reflect.Value{}, false},
+		{"reflect.Value pointer", &reflectedZero, false},
+		{"reflect.Value unexported", reflect.ValueOf(struct{ n int }{7}).Field(0), true},
+	}
+
+	for _, tc := range cases {
+		if result := boolable.From(tc.value); result != tc.expected {
+			t.Errorf("From(%s) = %v, expected %v", tc.name, result, tc.expected)
+		}
+	}
+
+	t.Run("Parse", func(t *testing.T) {
+		valid := map[string]interface{}{
+			"NullBool":      sql.NullBool{Bool: true, Valid: true},
+			"NullString":    sql.NullString{String: "no", Valid: true},
+			"json.Number":   json.Number("1.0"),
+			"big.Int":       big.NewInt(1),
+			"big.Float":     big.NewFloat(0),
+			"big.Rat":       big.NewRat(2, 2),
+			"time.Time":     time.Now(),
+			"Stringer":      status{"off"},
+			"Boolable":      feature{true},
+			"NullBool nil":  sql.NullBool{},
+			"reflect.Value": reflect.ValueOf("yes"),
+		}
+		for name, value := range valid {
+			if _, err := boolable.Parse(value); err != nil {
+				t.Errorf("Parse(%s) error = %v, expected nil", name, err)
+			}
+		}
+
+		invalid := map[string]interface{}{
+			"NullString":    sql.NullString{String: "maybe", Valid: true},
+			"NullInt64":     sql.NullInt64{Int64: 2, Valid: true},
+			"json.Number":   json.Number("2"),
+			"big.Int":       big.NewInt(5),
+			"Stringer":      status{"running"},
+			"valuer":        failingValuer{},
+			"reflect.Value": reflect.ValueOf(2),
+		}
+		for name, value := range invalid {
+			if _, err := boolable.Parse(value); !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Parse(%s) error = %v, expected ErrInvalid", name, err)
+			}
+		}
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		if result := boolable.Python().From(sql.NullString{String: "false", Valid: true}); result != true {
+			t.Errorf("Python.From(NullString false) = %v, expected true", result)
+		}
+		if result := boolable.Ruby().From(time.Time{}); result != true {
+			t.Errorf("Ruby.From(time.Time{}) = %v, expected true", result)
+		}
+		if result := boolable.Ruby().From(feature{false}); result != false {
+			t.Errorf("Ruby.From(Boolable false) = %v, expected false", result)
+		}
+	})
+}
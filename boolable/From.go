@@ -1,57 +1,15 @@
 package boolable
 
-import (
-	"reflect"
-	"strings"
-)
-
-var falseValues = map[string]bool{
-	"":      true,
-	"0":     true,
-	"f":     true,
-	"false": true,
-	"off":   true,
-	"n":     true,
-	"no":    true,
-}
-
-func fromPointer(value *reflect.Value) bool {
-	if value.IsNil() {
-		return false
-	}
-
-	elem := value.Elem()
-	switch elem.Kind() {
-	case reflect.Bool:
-		return elem.Bool()
-	case reflect.Int:
-		return elem.Int() != 0
-	case reflect.String:
-		return !falseValues[strings.ToLower(elem.String())]
-	case reflect.Interface:
-		return fromPointer(&elem)
-	default:
-		return true
-	}
-}
-
+// From converts value to a bool using the Default policy. Conversion is by kind,
+// so named types such as `type Toggle string` convert like their underlying type.
+// Numbers are false when zero, and strings are false when they are empty or one of
+// 0/f/false/off/n/no (case-insensitive). Pointers, at any depth, are dereferenced unless
+// dereference is false, in which case only nil pointers are false. Nil is false.
+// Types implementing Boolable or Truthy decide for themselves, and sql.Null* and other
+// driver.Valuer types, json.Number, time.Time and math/big numbers convert by content.
+// Other values are converted from their String method if they have one, and are
+// true otherwise.
 func From(value interface{}, dereference ...bool) bool {
-	reflectedValue := reflect.ValueOf(value)
-
-	if reflectedValue.Kind() == reflect.Ptr && (len(dereference) == 0 || dereference[0]) {
-		return fromPointer(&reflectedValue)
-	}
-
-	switch v := value.(type) {
-	case bool:
-		return v
-	case int:
-		return v != 0
-	case string:
-		return !falseValues[strings.ToLower(v)]
-	case nil:
-		return false
-	default:
-		return true
-	}
+	result, _ := defaultPolicy.parse(value, dereference)
+	return result
 }
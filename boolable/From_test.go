@@ -151,6 +151,82 @@ func TestBoolable(t *testing.T) {
 		})
 	})
 
+	t.Run("numeric kinds", func(t *testing.T) {
+		zeros := []interface{}{
+			int8(0), int16(0), int32(0), int64(0),
+			uint(0), uint8(0), uint16(0), uint32(0), uint64(0), uintptr(0),
+			float32(0), float64(0), complex64(0), complex128(0),
+		}
+		for _, value := range zeros {
+			if result := boolable.From(value); result != false {
+				t.Errorf("Boolable(%T(%v)) = %v, expected false", value, value, result)
+			}
+		}
+
+		nonZeros := []interface{}{
+			int8(-1), int16(2), int32(3), int64(-4),
+			uint(1), uint8(2), uint16(3), uint32(4), uint64(5), uintptr(6),
+			float32(0.5), float64(-0.1), complex64(1i), complex128(1),
+		}
+		for _, value := range nonZeros {
+			if result := boolable.From(value); result != true {
+				t.Errorf("Boolable(%T(%v)) = %v, expected true", value, value, result)
+			}
+		}
+	})
+
+	t.Run("named types", func(t *testing.T) {
+		type Toggle string
+		type Count uint8
+		type Flag bool
+
+		for _, test := range []struct {
+			value    interface{}
+			expected bool
+		}{
+			{Toggle("off"), false},
+			{Toggle("OFF"), false},
+			{Toggle("on"), true},
+			{Count(0), false},
+			{Count(3), true},
+			{Flag(false), false},
+			{Flag(true), true},
+		} {
+			if result := boolable.From(test.value); result != test.expected {
+				t.Errorf("Boolable(%T(%v)) = %v, expected %v", test.value, test.value, result, test.expected)
+			}
+		}
+	})
+
+	t.Run("any pointer depth", func(t *testing.T) {
+		zero := int64(0)
+		one := 1.5
+		pointer := &zero
+		var nilPointer *int64
+		var wrapped interface{} = &pointer
+
+		for _, test := range []struct {
+			value    interface{}
+			expected bool
+		}{
+			{&pointer, false},
+			{&wrapped, false},
+			{&nilPointer, false},
+			{func() **float64 { p := &one; return &p }(), true},
+		} {
+			if result := boolable.From(test.value); result != test.expected {
+				t.Errorf("Boolable(%T) = %v, expected %v", test.value, result, test.expected)
+			}
+			if result := boolable.From(test.value, false); result != true {
+				t.Errorf("Boolable(%T, false) = %v, expected true", test.value, result)
+			}
+		}
+
+		if result := boolable.From(nilPointer, false); result != false {
+			t.Errorf("Boolable(nil, false) = %v, expected false", result)
+		}
+	})
+
 	t.Run("nil is false", func(t *testing.T) {
 		result := boolable.From(nil)
 		if result != false {
@@ -380,3 +456,32 @@ func BenchmarkBoolable(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkFrom(b *testing.B) {
+	type Toggle string
+
+	zero := int64(0)
+	pointer := &zero
+
+	for _, bench := range []struct {
+		name  string
+		value interface{}
+	}{
+		{"bool", true},
+		{"int", 42},
+		{"string", "false"},
+		{"nil", nil},
+		{"int64", int64(42)},
+		{"float64", 0.5},
+		{"named string", Toggle("off")},
+		{"pointer", &zero},
+		{"pointer to pointer", &pointer},
+		{"struct", struct{}{}},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				boolable.From(bench.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package boolable
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Optional is a tri-state boolean: unset, true or false. Its zero value is unset,
+// so an explicit false survives merge.Structs and can be told apart from a value
+// that was never specified. Optional is comparable.
+//
+// Like Bool, it decodes from JSON, text, YAML and SQL with Parse. Empty strings and
+// null values decode as unset.
+type Optional struct {
+	value bool
+	set   bool
+}
+
+// OptionalOf returns an Optional set to value.
+func OptionalOf(value bool) Optional {
+	return Optional{value: value, set: true}
+}
+
+// ParseOptional parses value with Parse, returning an unset Optional for the empty string.
+func ParseOptional(value string) (Optional, error) {
+	var o Optional
+	err := o.decode(value)
+	return o, err
+}
+
+// IsSet reports whether o holds a value.
+func (o Optional) IsSet() bool {
+	return o.set
+}
+
+// Get returns the value and whether it is set.
+func (o Optional) Get() (value bool, ok bool) {
+	return o.value, o.set
+}
+
+// OrElse returns the value if it is set, and fallback otherwise.
+func (o Optional) OrElse(fallback bool) bool {
+	if o.set {
+		return o.value
+	}
+	return fallback
+}
+
+// Bool returns the value, or false if it is unset. It makes Optional a Boolable.
+func (o Optional) Bool() bool {
+	return o.value && o.set
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if it is unset.
+func (o Optional) Ptr() *bool {
+	if !o.set {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
+// String returns "true", "false" or "unset".
+func (o Optional) String() string {
+	if !o.set {
+		return "unset"
+	}
+	return Bool(o.value).String()
+}
+
+// MarshalJSON encodes o as a JSON boolean, or null if it is unset.
+func (o Optional) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes a JSON boolean, string or number, or null as unset.
+func (o *Optional) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+	return o.decode(value)
+}
+
+// MarshalText encodes o as "true" or "false", or as empty text if it is unset.
+func (o Optional) MarshalText() ([]byte, error) {
+	if !o.set {
+		return []byte{}, nil
+	}
+	return Bool(o.value).MarshalText()
+}
+
+// UnmarshalText decodes text with Parse, or empty text as unset.
+func (o *Optional) UnmarshalText(text []byte) error {
+	return o.decode(string(text))
+}
+
+// UnmarshalYAML decodes a YAML scalar like Bool.UnmarshalYAML, or null as unset.
+func (o *Optional) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value interface{}
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+	return o.decode(value)
+}
+
+// Scan implements sql.Scanner. NULL scans as unset.
+func (o *Optional) Scan(src interface{}) error {
+	if raw, ok := src.([]byte); ok {
+		src = string(raw)
+	}
+	return o.decode(src)
+}
+
+// Value implements driver.Valuer, returning nil if o is unset.
+func (o Optional) Value() (driver.Value, error) {
+	if !o.set {
+		return nil, nil
+	}
+	return o.value, nil
+}
+
+func (o *Optional) decode(value interface{}) error {
+	if value == nil || value == "" {
+		*o = Optional{}
+		return nil
+	}
+
+	result, err := Parse(value)
+	if err != nil {
+		return err
+	}
+	*o = OptionalOf(result)
+	return nil
+}
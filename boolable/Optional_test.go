@@ -0,0 +1,151 @@
+package boolable_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sampson-golang/utilities/boolable"
+	"github.com/sampson-golang/utilities/container/merge"
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestOptional(t *testing.T) {
+	t.Run("states", func(t *testing.T) {
+		var unset boolable.Optional
+		if unset.IsSet() || unset.OrElse(true) != true || unset.Bool() || unset.Ptr() != nil || unset.String() != "unset" {
+			t.Errorf("zero Optional = %v, expected unset", unset)
+		}
+
+		explicitFalse := boolable.OptionalOf(false)
+		if value, ok := explicitFalse.Get(); value || !ok {
+			t.Errorf("OptionalOf(false).Get() = %v, %v, expected false, true", value, ok)
+		}
+		if explicitFalse.OrElse(true) != false || *explicitFalse.Ptr() != false || explicitFalse.String() != "false" {
+			t.Errorf("OptionalOf(false) = %v, expected false", explicitFalse)
+		}
+		if explicitFalse == unset || boolable.OptionalOf(true) == explicitFalse {
+			t.Error("Optional states compare equal")
+		}
+
+		if boolable.From(boolable.OptionalOf(true)) != true || boolable.From(explicitFalse) || boolable.From(unset) {
+			t.Error("From(Optional) does not match Bool()")
+		}
+	})
+
+	t.Run("ParseOptional", func(t *testing.T) {
+		cases := map[string]boolable.Optional{
+			"":    {},
+			"yes": boolable.OptionalOf(true),
+			"Off": boolable.OptionalOf(false),
+			"0":   boolable.OptionalOf(false),
+		}
+		for input, expected := range cases {
+			if result, err := boolable.ParseOptional(input); result != expected || err != nil {
+				t.Errorf("ParseOptional(%q) = %v, %v, expected %v, nil", input, result, err, expected)
+			}
+		}
+
+		if _, err := boolable.ParseOptional("maybe"); !errors.Is(err, boolable.ErrInvalid) {
+			t.Errorf("ParseOptional(%q) error = %v, expected ErrInvalid", "maybe", err)
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		cases := map[string]boolable.Optional{
+			`null`:    {},
+			`""`:      {},
+			`false`:   boolable.OptionalOf(false),
+			`"on"`:    boolable.OptionalOf(true),
+			`0`:       boolable.OptionalOf(false),
+			`"FALSE"`: boolable.OptionalOf(false),
+		}
+		for input, expected := range cases {
+			value := boolable.OptionalOf(true)
+			if err := json.Unmarshal([]byte(input), &value); err != nil || value != expected {
+				t.Errorf("Unmarshal(%s) = %v, %v, expected %v, nil", input, value, err, expected)
+			}
+		}
+
+		var payload struct {
+			Enabled boolable.Optional `json:"enabled"`
+		}
+		if err := json.Unmarshal([]byte(`{}`), &payload); err != nil || payload.Enabled.IsSet() {
+			t.Errorf("Unmarshal({}) = %v, %v, expected unset", payload.Enabled, err)
+		}
+		if err := json.Unmarshal([]byte(`{"enabled":"maybe"}`), &payload); !errors.Is(err, boolable.ErrInvalid) {
+			t.Errorf("Unmarshal(maybe) error = %v, expected ErrInvalid", err)
+		}
+
+		data, err := json.Marshal([]boolable.Optional{{}, boolable.OptionalOf(true), boolable.OptionalOf(false)})
+		if err != nil || string(data) != `[null,true,false]` {
+			t.Errorf("Marshal = %s, %v, expected [null,true,false]", data, err)
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		for _, value := range []boolable.Optional{{}, boolable.OptionalOf(true), boolable.OptionalOf(false)} {
+			text, err := value.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText(%v) error = %v", value, err)
+			}
+
+			var decoded boolable.Optional
+			if err := decoded.UnmarshalText(text); err != nil || decoded != value {
+				t.Errorf("UnmarshalText(%q) = %v, %v, expected %v", text, decoded, err, value)
+			}
+		}
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		cases := map[interface{}]boolable.Optional{
+			nil:      {},
+			false:    boolable.OptionalOf(false),
+			int64(1): boolable.OptionalOf(true),
+			"f":      boolable.OptionalOf(false),
+		}
+		for src, expected := range cases {
+			value := boolable.OptionalOf(true)
+			if err := value.Scan(src); err != nil || value != expected {
+				t.Errorf("Scan(%v) = %v, %v, expected %v, nil", src, value, err, expected)
+			}
+		}
+
+		if result, err := (boolable.Optional{}).Value(); result != nil || err != nil {
+			t.Errorf("unset Value() = %v, %v, expected nil, nil", result, err)
+		}
+		if result, err := boolable.OptionalOf(false).Value(); result != false || err != nil {
+			t.Errorf("Value() = %v, %v, expected false, nil", result, err)
+		}
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		type Config struct {
+			Debug boolable.Optional
+			Cache boolable.Optional
+		}
+
+		config := &Config{Debug: boolable.OptionalOf(true), Cache: boolable.OptionalOf(true)}
+		merge.Structs(config, &Config{Debug: boolable.OptionalOf(false)})
+
+		if config.Debug != boolable.OptionalOf(false) {
+			t.Errorf("Debug = %v, expected an explicit false to override true", config.Debug)
+		}
+		if config.Cache != boolable.OptionalOf(true) {
+			t.Errorf("Cache = %v, expected an unset value to be skipped", config.Cache)
+		}
+	})
+
+	t.Run("env", func(t *testing.T) {
+		var config struct {
+			Debug boolable.Optional `env:"DEBUG"`
+			Cache boolable.Optional `env:"CACHE"`
+		}
+		if err := env.New(env.MapSource{"DEBUG": "false"}).Bind(&config); err != nil {
+			t.Fatalf("Bind error = %v", err)
+		}
+		if config.Debug != boolable.OptionalOf(false) || config.Cache.IsSet() {
+			t.Errorf("Bind = %v, %v, expected false and unset", config.Debug, config.Cache)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package boolable
+
+import (
+	"errors"
+)
+
+// ErrInvalid is wrapped by every error returned from Parse.
+var ErrInvalid = errors.New("invalid boolean")
+
+// Parse is a strict From, using the Strict policy: strings must be one of
+// true/t/yes/y/on/1 or false/f/no/n/off/0 (case-insensitive) and numbers of any kind
+// must be 1 or 0. Anything else, including the empty string and types From would
+// call true, returns an error wrapping ErrInvalid.
+// Pointers are dereferenced like From, and nil values are false.
+func Parse(value interface{}, dereference ...bool) (bool, error) {
+	return strictPolicy.parse(value, dereference)
+}
@@ -0,0 +1,174 @@
+package boolable_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/boolable"
+)
+
+func TestParse(t *testing.T) {
+	trueStrings := []string{"1", "t", "true", "on", "y", "yes"}
+	falseStrings := []string{"0", "f", "false", "off", "n", "no"}
+	invalidStrings := []string{"", "maybe", "ture", "2", "yess", " true", "enabled"}
+
+	t.Run("string", func(t *testing.T) {
+		for _, value := range trueStrings {
+			for _, variant := range []string{value, strings.ToUpper(value), strings.ToUpper(value[:1]) + value[1:]} {
+				if result, err := boolable.Parse(variant); result != true || err != nil {
+					t.Errorf("Parse(%q) = %v, %v, expected true, nil", variant, result, err)
+				}
+			}
+		}
+
+		for _, value := range falseStrings {
+			for _, variant := range []string{value, strings.ToUpper(value), strings.ToUpper(value[:1]) + value[1:]} {
+				if result, err := boolable.Parse(variant); result != false || err != nil {
+					t.Errorf("Parse(%q) = %v, %v, expected false, nil", variant, result, err)
+				}
+			}
+		}
+
+		for _, value := range invalidStrings {
+			if result, err := boolable.Parse(value); result != false || !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Parse(%q) = %v, %v, expected false, ErrInvalid", value, result, err)
+			}
+		}
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		for _, value := range []bool{true, false} {
+			if result, err := boolable.Parse(value); result != value || err != nil {
+				t.Errorf("Parse(%v) = %v, %v, expected %v, nil", value, result, err, value)
+			}
+		}
+	})
+
+	t.Run("int", func(t *testing.T) {
+		if result, err := boolable.Parse(1); result != true || err != nil {
+			t.Errorf("Parse(1) = %v, %v, expected true, nil", result, err)
+		}
+		if result, err := boolable.Parse(0); result != false || err != nil {
+			t.Errorf("Parse(0) = %v, %v, expected false, nil", result, err)
+		}
+		for _, value := range []int{-1, 2, 42} {
+			if _, err := boolable.Parse(value); !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Parse(%v) error = %v, expected ErrInvalid", value, err)
+			}
+		}
+	})
+
+	t.Run("numeric kinds", func(t *testing.T) {
+		for _, value := range []interface{}{int8(1), int64(1), uint(1), uint8(1), float32(1), float64(1), complex128(1)} {
+			if result, err := boolable.Parse(value); result != true || err != nil {
+				t.Errorf("Parse(%T(%v)) = %v, %v, expected true, nil", value, value, result, err)
+			}
+		}
+		for _, value := range []interface{}{int16(0), uint64(0), uintptr(0), float64(0), complex64(0)} {
+			if result, err := boolable.Parse(value); result != false || err != nil {
+				t.Errorf("Parse(%T(%v)) = %v, %v, expected false, nil", value, value, result, err)
+			}
+		}
+		for _, value := range []interface{}{int64(2), uint8(255), float64(0.5), complex128(1i)} {
+			if _, err := boolable.Parse(value); !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Parse(%T(%v)) error = %v, expected ErrInvalid", value, value, err)
+			}
+		}
+	})
+
+	t.Run("named types", func(t *testing.T) {
+		type Toggle string
+
+		if result, err := boolable.Parse(Toggle("Yes")); result != true || err != nil {
+			t.Errorf("Parse(Toggle(\"Yes\")) = %v, %v, expected true, nil", result, err)
+		}
+
+		on := Toggle("maybe")
+		pointer := &on
+		if _, err := boolable.Parse(&pointer); !errors.Is(err, boolable.ErrInvalid) {
+			t.Errorf("Parse(**Toggle) error = %v, expected ErrInvalid", err)
+		}
+	})
+
+	t.Run("nil is false", func(t *testing.T) {
+		var nilString *string
+		for _, value := range []interface{}{nil, nilString} {
+			if result, err := boolable.Parse(value); result != false || err != nil {
+				t.Errorf("Parse(%v) = %v, %v, expected false, nil", value, result, err)
+			}
+		}
+	})
+
+	t.Run("unsupported types", func(t *testing.T) {
+		for _, value := range []interface{}{struct{}{}, []bool{true}, map[string]bool{}, 1.5} {
+			if _, err := boolable.Parse(value); !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Parse(%v) error = %v, expected ErrInvalid", value, err)
+			}
+		}
+	})
+
+	t.Run("optional dereference parameter", func(t *testing.T) {
+		yes, no, typo, one := "yes", "no", "ture", 1
+		var wrapped interface{} = "off"
+
+		for _, test := range []struct {
+			value    interface{}
+			expected bool
+		}{
+			{&yes, true},
+			{&no, false},
+			{&one, true},
+			{&wrapped, false},
+		} {
+			if result, err := boolable.Parse(test.value); result != test.expected || err != nil {
+				t.Errorf("Parse(%v) = %v, %v, expected %v, nil", test.value, result, err, test.expected)
+			}
+			if result, err := boolable.Parse(test.value, true); result != test.expected || err != nil {
+				t.Errorf("Parse(%v, true) = %v, %v, expected %v, nil", test.value, result, err, test.expected)
+			}
+			if result, err := boolable.Parse(test.value, false); result != true || err != nil {
+				t.Errorf("Parse(%v, false) = %v, %v, expected true, nil", test.value, result, err)
+			}
+		}
+
+		if _, err := boolable.Parse(&typo); !errors.Is(err, boolable.ErrInvalid) {
+			t.Errorf("Parse(&%q) error = %v, expected ErrInvalid", typo, err)
+		}
+
+		var nilString *string
+		if result, err := boolable.Parse(nilString, false); result != false || err != nil {
+			t.Errorf("Parse(nil, false) = %v, %v, expected false, nil", result, err)
+		}
+	})
+
+	t.Run("error names the value", func(t *testing.T) {
+		if _, err := boolable.Parse("maybe"); err == nil || err.Error() != `boolable: invalid boolean "maybe"` {
+			t.Errorf("Parse(\"maybe\") error = %v", err)
+		}
+	})
+}
+
+func BenchmarkParse(b *testing.B) {
+	type Toggle string
+
+	yes := "yes"
+
+	for _, bench := range []struct {
+		name  string
+		value interface{}
+	}{
+		{"bool", true},
+		{"int", 1},
+		{"string", "false"},
+		{"int64", int64(1)},
+		{"named string", Toggle("off")},
+		{"pointer", &yes},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				boolable.Parse(bench.value)
+			}
+		})
+	}
+}
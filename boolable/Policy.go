@@ -0,0 +1,286 @@
+package boolable
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"strings"
+)
+
+// Unknown decides how a Policy treats strings in neither of its vocabularies.
+type Unknown int
+
+const (
+	// UnknownTrue treats unknown strings as true, like From.
+	UnknownTrue Unknown = iota
+	// UnknownFalse treats unknown strings as false.
+	UnknownFalse
+	// UnknownError rejects unknown strings, like Parse.
+	UnknownError
+)
+
+// Policy describes how values are converted to booleans.
+type Policy struct {
+	// True and False are the strings recognised as true and false.
+	True  []string
+	False []string
+	// CaseSensitive requires strings to match a vocabulary exactly.
+	CaseSensitive bool
+	// Unknown decides strings in neither vocabulary.
+	Unknown Unknown
+	// EmptyCollectionsFalse makes empty slices, arrays and maps false.
+	EmptyCollectionsFalse bool
+	// NilFalse makes nil channels, funcs, maps, slices and unsafe pointers false.
+	NilFalse bool
+	// NaNFalse makes NaN floats and complex numbers false.
+	NaNFalse bool
+	// ZeroTrue makes zero numbers true.
+	ZeroTrue bool
+	// StrictTypes rejects numbers other than 0 and 1, and values with no boolean meaning,
+	// such as structs and non-empty collections, instead of treating them as true.
+	StrictTypes bool
+
+	// words maps each vocabulary word, lowercased unless CaseSensitive, to its value.
+	// It is built once for the package's own policies; other policies search True and False.
+	words map[string]bool
+}
+
+// The policies behind From and Parse. They are unexported so no importer can change
+// how From, Parse and everything built on them behave.
+var (
+	defaultPolicy = Default().compile()
+	strictPolicy  = Strict().compile()
+)
+
+// Default returns the Policy used by From. Each call returns a new copy, which may be
+// adjusted freely.
+func Default() Policy {
+	return Policy{
+		True:  []string{"1", "t", "true", "on", "y", "yes"},
+		False: []string{"", "0", "f", "false", "off", "n", "no"},
+	}
+}
+
+// Strict returns the Policy used by Parse. Each call returns a new copy.
+func Strict() Policy {
+	return Policy{
+		True:        []string{"1", "t", "true", "on", "y", "yes"},
+		False:       []string{"0", "f", "false", "off", "n", "no"},
+		Unknown:     UnknownError,
+		StrictTypes: true,
+	}
+}
+
+// Python returns a Policy that treats values like Python's bool(): zero numbers,
+// empty strings and empty or nil collections are false, and every other string is true.
+func Python() Policy {
+	return Policy{
+		False:                 []string{""},
+		EmptyCollectionsFalse: true,
+		NilFalse:              true,
+	}
+}
+
+// JavaScript returns a Policy that treats values like JavaScript's Boolean(): zero
+// numbers, NaN, empty strings and nil values are false, while empty collections are true.
+func JavaScript() Policy {
+	return Policy{
+		False:    []string{""},
+		NilFalse: true,
+		NaNFalse: true,
+	}
+}
+
+// Ruby returns a Policy that treats values like Ruby, where only nil and false are false.
+func Ruby() Policy {
+	return Policy{
+		NilFalse: true,
+		ZeroTrue: true,
+	}
+}
+
+// From converts value like the package-level From, using the policy's rules.
+// Values the policy rejects are false.
+func (p Policy) From(value interface{}, dereference ...bool) bool {
+	result, _ := p.parse(value, dereference)
+	return result
+}
+
+// Parse converts value like the package-level Parse, using the policy's rules.
+// Rejected values return an error wrapping ErrInvalid.
+func (p Policy) Parse(value interface{}, dereference ...bool) (bool, error) {
+	return p.parse(value, dereference)
+}
+
+func (p *Policy) parse(value interface{}, dereference []bool) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case int:
+		if v == 0 || v == 1 || !p.StrictTypes {
+			return v != 0 || p.ZeroTrue, nil
+		}
+	case string:
+		return p.parseString(v)
+	case nil:
+		return false, nil
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	if reflectedValue.Kind() == reflect.Ptr {
+		if reflectedValue.IsNil() {
+			return false, nil
+		}
+		if len(dereference) > 0 && !dereference[0] {
+			return true, nil
+		}
+	}
+
+	switch value.(type) {
+	case int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr,
+		float32, float64, complex64, complex128,
+		*bool, *int, *int8, *int16, *int32, *int64, *uint, *uint8, *uint16, *uint32, *uint64, *uintptr,
+		*float32, *float64, *complex64, *complex128, *string:
+		// Predeclared types and pointers to them have no methods to check.
+		return p.parseValue(reflectedValue, nil, false)
+	}
+
+	var str fmt.Stringer
+	if result, ok, err := p.parseMethods(value, &str); ok {
+		return result, err
+	}
+	return p.parseValue(reflectedValue, str, true)
+}
+
+// parseValue converts value by kind, once its methods have been checked. Pointers and
+// interfaces are followed, checking each level for methods that decide the result,
+// and str keeps the innermost fmt.Stringer for values with no boolean meaning of their own.
+// Methods are only checked if probe is set.
+func (p *Policy) parseValue(value reflect.Value, str fmt.Stringer, probe bool) (bool, error) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return false, nil
+		}
+		value = value.Elem()
+
+		if probe && hasMethods(value.Type()) && value.CanInterface() {
+			if result, ok, err := p.parseMethods(value.Interface(), &str); ok {
+				return result, err
+			}
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Map, reflect.Slice, reflect.UnsafePointer:
+		if p.NilFalse && value.IsNil() {
+			return false, nil
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return p.parseNumber(value, value.Int() == 1, value.Int() == 0)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return p.parseNumber(value, value.Uint() == 1, value.Uint() == 0)
+	case reflect.Float32, reflect.Float64:
+		if p.NaNFalse && math.IsNaN(value.Float()) {
+			return false, nil
+		}
+		return p.parseNumber(value, value.Float() == 1, value.Float() == 0)
+	case reflect.Complex64, reflect.Complex128:
+		if p.NaNFalse && cmplx.IsNaN(value.Complex()) {
+			return false, nil
+		}
+		return p.parseNumber(value, value.Complex() == 1, value.Complex() == 0)
+	case reflect.String:
+		return p.parseString(value.String())
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if p.EmptyCollectionsFalse && value.Len() == 0 {
+			return false, nil
+		}
+	}
+
+	if str != nil {
+		return p.parseString(str.String())
+	}
+	if p.StrictTypes {
+		return false, fmt.Errorf("boolable: %w: unsupported type %s", ErrInvalid, value.Type())
+	}
+	return true, nil
+}
+
+// parseNumber treats zero as false unless ZeroTrue is set, and other numbers as true
+// unless StrictTypes limits them to 1.
+func (p *Policy) parseNumber(value reflect.Value, one bool, zero bool) (bool, error) {
+	switch {
+	case zero:
+		return p.ZeroTrue, nil
+	case one || !p.StrictTypes:
+		return true, nil
+	default:
+		return false, fmt.Errorf("boolable: %w %v", ErrInvalid, value.Interface())
+	}
+}
+
+func (p *Policy) parseString(value string) (bool, error) {
+	if result, ok := p.lookup(value); ok {
+		return result, nil
+	}
+
+	switch p.Unknown {
+	case UnknownTrue:
+		return true, nil
+	case UnknownFalse:
+		return false, nil
+	default:
+		return false, fmt.Errorf("boolable: %w %q", ErrInvalid, value)
+	}
+}
+
+// compile returns p with its vocabularies indexed, so strings are matched with a map lookup.
+func (p Policy) compile() Policy {
+	p.words = make(map[string]bool, len(p.True)+len(p.False))
+	for _, vocabulary := range []struct {
+		words []string
+		value bool
+	}{{p.False, false}, {p.True, true}} {
+		for _, word := range vocabulary.words {
+			if !p.CaseSensitive {
+				word = strings.ToLower(word)
+			}
+			p.words[word] = vocabulary.value
+		}
+	}
+	return p
+}
+
+// lookup reports the value of value in the vocabularies, and whether it is in either.
+func (p *Policy) lookup(value string) (bool, bool) {
+	if p.words != nil {
+		if !p.CaseSensitive {
+			value = strings.ToLower(value)
+		}
+		result, ok := p.words[value]
+		return result, ok
+	}
+
+	if p.matches(p.True, value) {
+		return true, true
+	}
+	if p.matches(p.False, value) {
+		return false, true
+	}
+	return false, false
+}
+
+func (p *Policy) matches(vocabulary []string, value string) bool {
+	for _, word := range vocabulary {
+		if word == value || (!p.CaseSensitive && strings.EqualFold(word, value)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package boolable_test
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+	"testing"
+	"unsafe"
+
+	"github.com/sampson-golang/utilities/boolable"
+)
+
+func TestPolicy(t *testing.T) {
+	german := boolable.Policy{
+		True:    []string{"ja", "an", "aktiv"},
+		False:   []string{"nein", "aus", "inaktiv", ""},
+		Unknown: boolable.UnknownError,
+	}
+
+	t.Run("vocabulary", func(t *testing.T) {
+		cases := map[string]bool{"ja": true, "JA": true, "Aktiv": true, "nein": false, "AUS": false, "": false}
+		for value, expected := range cases {
+			if result, err := german.Parse(value); result != expected || err != nil {
+				t.Errorf("Parse(%q) = %v, %v, expected %v, nil", value, result, err, expected)
+			}
+		}
+
+		for _, value := range []string{"yes", "false", "maybe"} {
+			if result, err := german.Parse(value); result != false || !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Parse(%q) = %v, %v, expected false, ErrInvalid", value, result, err)
+			}
+			if german.From(value) {
+				t.Errorf("From(%q) = true, expected false for a rejected value", value)
+			}
+		}
+	})
+
+	t.Run("case sensitive", func(t *testing.T) {
+		policy := german
+		policy.CaseSensitive = true
+
+		if result, err := policy.Parse("ja"); result != true || err != nil {
+			t.Errorf("Parse(%q) = %v, %v, expected true, nil", "ja", result, err)
+		}
+		if _, err := policy.Parse("JA"); !errors.Is(err, boolable.ErrInvalid) {
+			t.Errorf("Parse(%q) error = %v, expected ErrInvalid", "JA", err)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		cases := map[boolable.Unknown]bool{boolable.UnknownTrue: true, boolable.UnknownFalse: false}
+		for mode, expected := range cases {
+			policy := boolable.Policy{True: []string{"enabled"}, False: []string{"disabled"}, Unknown: mode}
+			if result, err := policy.Parse("maybe"); result != expected || err != nil {
+				t.Errorf("Unknown %v: Parse(%q) = %v, %v, expected %v, nil", mode, "maybe", result, err, expected)
+			}
+			if result := policy.From("disabled"); result != false {
+				t.Errorf("Unknown %v: From(%q) = %v, expected false", mode, "disabled", result)
+			}
+		}
+	})
+
+	t.Run("empty collections", func(t *testing.T) {
+		policy := boolable.Default()
+		policy.EmptyCollectionsFalse = true
+
+		cases := []struct {
+			value    interface{}
+			expected bool
+		}{
+			{[]int{}, false},
+			{[]int{1}, true},
+			{map[string]int{}, false},
+			{map[string]int{"a": 1}, true},
+			{[0]int{}, false},
+			{&[]string{}, false},
+		}
+		for _, tc := range cases {
+			if result := policy.From(tc.value); result != tc.expected {
+				t.Errorf("From(%#v) = %v, expected %v", tc.value, result, tc.expected)
+			}
+			if result := boolable.From(tc.value); result != true {
+				t.Errorf("Default From(%#v) = %v, expected true", tc.value, result)
+			}
+		}
+	})
+
+	t.Run("strict types", func(t *testing.T) {
+		policy := boolable.Default()
+		policy.StrictTypes = true
+
+		for _, value := range []interface{}{2, int64(-1), 0.5, struct{}{}, []int{1}} {
+			if result, err := policy.Parse(value); result != false || !errors.Is(err, boolable.ErrInvalid) {
+				t.Errorf("Parse(%#v) = %v, %v, expected false, ErrInvalid", value, result, err)
+			}
+		}
+		if result, err := policy.Parse("maybe"); result != true || err != nil {
+			t.Errorf("Parse(%q) = %v, %v, expected true, nil", "maybe", result, err)
+		}
+	})
+
+	t.Run("copies", func(t *testing.T) {
+		policy := boolable.Strict()
+		policy.True[0] = "maybe"
+		policy.Unknown = boolable.UnknownTrue
+
+		if _, err := boolable.Parse("maybe"); !errors.Is(err, boolable.ErrInvalid) {
+			t.Errorf("Parse(%q) error = %v after changing a copy of Strict, expected ErrInvalid", "maybe", err)
+		}
+		if result, err := boolable.Strict().Parse("1"); result != true || err != nil {
+			t.Errorf("Strict().Parse(%q) = %v, %v after changing an earlier copy, expected true, nil", "1", result, err)
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		values := []interface{}{"", "no", "maybe", 0, 2, uint8(1), 0.5, struct{}{}, nil}
+		for _, value := range values {
+			if boolable.Default().From(value) != boolable.From(value) {
+				t.Errorf("Default.From(%#v) differs from From", value)
+			}
+
+			result, err := boolable.Strict().Parse(value)
+			expected, expectedErr := boolable.Parse(value)
+			if result != expected || (err == nil) != (expectedErr == nil) {
+				t.Errorf("Strict.Parse(%#v) = %v, %v, Parse = %v, %v", value, result, err, expected, expectedErr)
+			}
+		}
+	})
+}
+
+func TestPolicyModes(t *testing.T) {
+	type Toggle string
+	var (
+		nilChan    chan int
+		nilFunc    func()
+		nilMap     map[string]int
+		nilSlice   []int
+		nilPointer *int
+		nilUnsafe  unsafe.Pointer
+		iface      interface{} = 0
+		number                 = 1
+	)
+
+	cases := []struct {
+		name       string
+		value      interface{}
+		python     bool
+		javascript bool
+		ruby       bool
+	}{
+		{"nil", nil, false, false, false},
+		{"false", false, false, false, false},
+		{"true", true, true, true, true},
+		{"int zero", 0, false, false, true},
+		{"int", -3, true, true, true},
+		{"int8 zero", int8(0), false, false, true},
+		{"int16", int16(2), true, true, true},
+		{"int32 zero", int32(0), false, false, true},
+		{"int64", int64(1), true, true, true},
+		{"uint zero", uint(0), false, false, true},
+		{"uint8", uint8(7), true, true, true},
+		{"uint16 zero", uint16(0), false, false, true},
+		{"uint32", uint32(1), true, true, true},
+		{"uint64 zero", uint64(0), false, false, true},
+		{"uintptr", uintptr(1), true, true, true},
+		{"float32 zero", float32(0), false, false, true},
+		{"float64 negative zero", math.Copysign(0, -1), false, false, true},
+		{"float64", 0.5, true, true, true},
+		{"float64 NaN", math.NaN(), true, false, true},
+		{"float64 infinity", math.Inf(1), true, true, true},
+		{"complex64 zero", complex64(0), false, false, true},
+		{"complex128", complex(0, 1), true, true, true},
+		{"complex128 NaN", cmplx.NaN(), true, false, true},
+		{"empty string", "", false, false, true},
+		{"string", "a", true, true, true},
+		{"false string", "false", true, true, true},
+		{"zero string", "0", true, true, true},
+		{"named string", Toggle(""), false, false, true},
+		{"empty array", [0]int{}, false, true, true},
+		{"array", [1]int{0}, true, true, true},
+		{"nil slice", nilSlice, false, false, false},
+		{"empty slice", []int{}, false, true, true},
+		{"slice", []int{0}, true, true, true},
+		{"nil map", nilMap, false, false, false},
+		{"empty map", map[string]int{}, false, true, true},
+		{"map", map[string]int{"a": 0}, true, true, true},
+		{"nil chan", nilChan, false, false, false},
+		{"chan", make(chan int), true, true, true},
+		{"nil func", nilFunc, false, false, false},
+		{"func", func() {}, true, true, true},
+		{"nil unsafe pointer", nilUnsafe, false, false, false},
+		{"unsafe pointer", unsafe.Pointer(&number), true, true, true},
+		{"struct", struct{}{}, true, true, true},
+		{"nil pointer", nilPointer, false, false, false},
+		{"pointer to zero", new(int), false, false, true},
+		{"pointer to empty slice", &[]int{}, false, true, true},
+		{"interface holding zero", &iface, false, false, true},
+	}
+
+	policies := []struct {
+		name     string
+		policy   boolable.Policy
+		expected func(int) bool
+	}{
+		{"Python", boolable.Python(), func(i int) bool { return cases[i].python }},
+		{"JavaScript", boolable.JavaScript(), func(i int) bool { return cases[i].javascript }},
+		{"Ruby", boolable.Ruby(), func(i int) bool { return cases[i].ruby }},
+	}
+
+	for _, p := range policies {
+		t.Run(p.name, func(t *testing.T) {
+			for i, tc := range cases {
+				if result := p.policy.From(tc.value); result != p.expected(i) {
+					t.Errorf("%s.From(%s) = %v, expected %v", p.name, tc.name, result, p.expected(i))
+				}
+				if result, err := p.policy.Parse(tc.value); result != p.expected(i) || err != nil {
+					t.Errorf("%s.Parse(%s) = %v, %v, expected %v, nil", p.name, tc.name, result, err, p.expected(i))
+				}
+			}
+
+			if result := p.policy.From(nilPointer, false); result != false {
+				t.Errorf("%s.From(nil pointer, false) = %v, expected false", p.name, result)
+			}
+			if result := p.policy.From(new(int), false); result != true {
+				t.Errorf("%s.From(pointer, false) = %v, expected true", p.name, result)
+			}
+		})
+	}
+}
+
+func BenchmarkPolicy(b *testing.B) {
+	policy := boolable.Policy{
+		True:  []string{"ja", "an", "aktiv"},
+		False: []string{"nein", "aus", "inaktiv", ""},
+	}
+
+	for i := 0; i < b.N; i++ {
+		policy.From("Inaktiv")
+	}
+}
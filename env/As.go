@@ -0,0 +1,33 @@
+package env
+
+import (
+	"reflect"
+)
+
+// As looks up key like Lookup and converts the value to T.
+// Conversion supports the same types as Bind, including any type whose pointer
+// implements encoding.TextUnmarshaler, and slices and maps split with DefaultSplitter.
+// An unset or empty value yields the zero value of T.
+func As[T any](key string, fallbacks ...string) (T, error) {
+	var result T
+	err := std.as(reflect.ValueOf(&result).Elem(), key, fallbacks)
+	return result, err
+}
+
+// as looks up key like Lookup and converts the value into target.
+func (e *Env) as(target reflect.Value, key string, fallbacks []string) error {
+	return e.asWith(target, key, fallbacks, DefaultSplitter)
+}
+
+// asWith is as, splitting slices and maps with splitter.
+func (e *Env) asWith(target reflect.Value, key string, fallbacks []string, splitter Splitter) error {
+	lookup := e.LookupDetailed(key, fallbacks...)
+	if lookup.Err != nil {
+		return lookup.Err
+	}
+
+	if err := convertWith(lookup.Value, target, splitter); err != nil {
+		return &ParseError{Key: lookup.parseKey(), Value: lookup.Value, Err: err}
+	}
+	return nil
+}
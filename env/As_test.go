@@ -0,0 +1,95 @@
+package env_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+type asLevel int
+
+func (l *asLevel) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "debug":
+		*l = 0
+	case "info":
+		*l = 1
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+func TestAs(t *testing.T) {
+	os.Setenv("TEST_AS_INT", "42")
+	defer os.Unsetenv("TEST_AS_INT")
+	os.Setenv("TEST_AS_LEVEL", "INFO")
+	defer os.Unsetenv("TEST_AS_LEVEL")
+	os.Setenv("TEST_AS_BAD", "forty-two")
+	defer os.Unsetenv("TEST_AS_BAD")
+	os.Setenv("TEST_AS_EMPTY", "")
+	defer os.Unsetenv("TEST_AS_EMPTY")
+
+	t.Run("parses the value", func(t *testing.T) {
+		got, err := env.As[int64]("TEST_AS_INT")
+		if err != nil || got != 42 {
+			t.Errorf("As[int64]() = %v, %v, want %v, nil", got, err, 42)
+		}
+	})
+
+	t.Run("honours encoding.TextUnmarshaler", func(t *testing.T) {
+		got, err := env.As[asLevel]("TEST_AS_LEVEL")
+		if err != nil || got != 1 {
+			t.Errorf("As[asLevel]() = %v, %v, want %v, nil", got, err, 1)
+		}
+	})
+
+	t.Run("follows the fallback chain", func(t *testing.T) {
+		got, err := env.As[uint8]("TEST_AS_NOT_EXISTS", "TEST_AS_INT", "7")
+		if err != nil || got != 42 {
+			t.Errorf("As[uint8]() = %v, %v, want %v, nil", got, err, 42)
+		}
+
+		got, err = env.As[uint8]("TEST_AS_NOT_EXISTS", "TEST_AS_NOT_EXISTS_2", "7")
+		if err != nil || got != 7 {
+			t.Errorf("As[uint8]() = %v, %v, want %v, nil", got, err, 7)
+		}
+	})
+
+	t.Run("unset and empty values are zero", func(t *testing.T) {
+		for _, key := range []string{"TEST_AS_NOT_EXISTS", "TEST_AS_EMPTY"} {
+			got, err := env.As[float32](key)
+			if err != nil || got != 0 {
+				t.Errorf("As[float32](%v) = %v, %v, want 0, nil", key, got, err)
+			}
+		}
+	})
+
+	t.Run("reports the key and value of malformed input", func(t *testing.T) {
+		_, err := env.As[int]("TEST_AS_BAD")
+
+		var parseErr *env.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Fatalf("As[int]() error = %v, want *env.ParseError", err)
+		}
+		if parseErr.Key != "TEST_AS_BAD" || parseErr.Value != "forty-two" {
+			t.Errorf("ParseError = %+v", parseErr)
+		}
+		if !strings.Contains(err.Error(), "TEST_AS_BAD") || !strings.Contains(err.Error(), `"forty-two"`) {
+			t.Errorf("Error() = %v, want key and value named", err)
+		}
+
+		if _, err := env.As[asLevel]("TEST_AS_BAD"); err == nil {
+			t.Errorf("As[asLevel]() error = nil, want error")
+		}
+	})
+
+	t.Run("out of range values are malformed", func(t *testing.T) {
+		if _, err := env.As[int8]("TEST_AS_NOT_EXISTS", "300"); err == nil {
+			t.Errorf("As[int8]() error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package env
+
+// Bind populates the struct pointed to by target from environment variables.
+// Fields are matched with `env:"KEY,FALLBACK_KEY"` tags, resolved like Lookup,
+// and may declare `default:"value"` and `required:"true"` tags. Fields tagged
+// `secret:"true"` are redacted from Config. Slice and map fields are split with
+// DefaultSplitter, overridden by `separator:";"` and `pairSeparator:":"` tags.
+// Untagged struct fields are bound recursively, with keys prefixed by their
+// `envPrefix:"PREFIX_"` tag; nil pointers to them are only allocated once a field
+// inside is set, and a struct is not walked again inside itself. Every missing or malformed variable is reported
+// in a single *BindError.
+func Bind(target interface{}) error {
+	return std.Bind(target)
+}
+
+func (e *Env) Bind(target interface{}) error {
+	fields, err := fields(target)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, f := range fields {
+		if err := e.bindField(f); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return &BindError{Errors: errs}
+	}
+	return nil
+}
+
+func (e *Env) bindField(f field) error {
+	if f.secret() {
+		for _, name := range e.prefixed(f.keys) {
+			e.trail.markSecret(name)
+		}
+	}
+
+	result := e.LookupDetailed(f.keys[0], f.fallbacks()...)
+	if result.Err != nil {
+		return result.Err
+	}
+
+	if !result.Found {
+		if _, hasDefault := f.defaultValue(); !hasDefault {
+			if f.required() {
+				return &MissingError{Keys: e.prefixed(f.keys)}
+			}
+			return nil
+		}
+	}
+
+	if err := f.set(result.Value); err != nil {
+		return &ParseError{Key: result.parseKey(), Value: result.Value, Err: err}
+	}
+	return nil
+}
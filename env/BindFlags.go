@@ -0,0 +1,145 @@
+package env
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// BindFlags registers a flag on fs for every field of the struct pointed to by target,
+// so command-line tools accept both --port and PORT. Fields are first set from
+// the environment like Bind, making those values the flags' defaults; flags given
+// on the command line then override them when fs is parsed.
+//
+// Flag names come from the `flag` tag, or are derived from the field's primary key,
+// so DATABASE_URL becomes -database-url; `flag:"-"` skips a field. Usage text comes
+// from the `description` tag, followed by the variables backing the flag.
+// Defaults of secret fields are not shown in usage. Required fields are not enforced,
+// since the flag may still provide them. Malformed variables are reported in a *BindError.
+func BindFlags(fs *flag.FlagSet, target interface{}) error {
+	return std.BindFlags(fs, target)
+}
+
+func (e *Env) BindFlags(fs *flag.FlagSet, target interface{}) error {
+	fields, err := fields(target)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, f := range fields {
+		name := f.flagName()
+		if name == "-" {
+			continue
+		}
+
+		if err := e.bindField(f); err != nil {
+			if _, missing := err.(*MissingError); !missing {
+				errs = append(errs, err)
+			}
+		}
+
+		keys := e.prefixed(f.keys)
+		fs.Var(&flagValue{field: f}, name, f.flagUsage(keys))
+		if f.secret() || redacts(keys) {
+			fs.Lookup(name).DefValue = ""
+		}
+	}
+
+	if len(errs) > 0 {
+		return &BindError{Errors: errs}
+	}
+	return nil
+}
+
+func (f field) flagName() string {
+	if name := f.tag.Get("flag"); name != "" {
+		return name
+	}
+	return strings.ReplaceAll(strings.ToLower(f.keys[0]), "_", "-")
+}
+
+// flagUsage returns the description followed by keys, the variables backing the flag.
+func (f field) flagUsage(keys []string) string {
+	usage := f.description()
+	if usage != "" {
+		usage += " "
+	}
+	return usage + "(env " + strings.Join(keys, ", ") + ")"
+}
+
+// flagValue adapts a struct field to flag.Value, parsing like Bind.
+type flagValue struct {
+	field field
+}
+
+func (v *flagValue) Set(value string) error {
+	return v.field.set(value)
+}
+
+func (v *flagValue) String() string {
+	// The flag package calls String on a zero flagValue to detect default values.
+	if !v.field.value.IsValid() {
+		return ""
+	}
+	return formatValue(v.field.value, v.field.splitter())
+}
+
+// IsBoolFlag lets boolean fields be set with a bare -flag.
+func (v *flagValue) IsBoolFlag() bool {
+	return v.field.value.IsValid() && indirectType(v.field.value.Type()).Kind() == reflect.Bool
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// formatValue formats value the way convert would parse it.
+func formatValue(value reflect.Value, splitter Splitter) string {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+
+	if value.CanAddr() {
+		switch v := value.Addr().Interface().(type) {
+		case encoding.TextMarshaler:
+			text, err := v.MarshalText()
+			if err == nil {
+				return string(text)
+			}
+		case fmt.Stringer:
+			return v.String()
+		}
+	}
+
+	switch value.Kind() {
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return string(value.Bytes())
+		}
+		elements := make([]string, value.Len())
+		for i := range elements {
+			elements[i] = formatValue(value.Index(i), splitter)
+		}
+		return strings.Join(elements, splitter.separator())
+	case reflect.Map:
+		pairs := make([]string, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			pairs = append(pairs, formatValue(iter.Key(), splitter)+splitter.pair()+formatValue(iter.Value(), splitter))
+		}
+		sort.Strings(pairs)
+		return strings.Join(pairs, splitter.separator())
+	}
+
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,146 @@
+package env_test
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+type bindFlagsTestConfig struct {
+	Port     int           `env:"PORT,HTTP_PORT" default:"8080" description:"Port to listen on."`
+	Debug    bool          `env:"DEBUG"`
+	Timeout  time.Duration `env:"TIMEOUT" default:"30s" flag:"wait"`
+	Tags     []string      `env:"TAGS"`
+	Password string        `env:"DB_PASSWORD" description:"Database password."`
+	Internal string        `env:"INTERNAL" flag:"-"`
+}
+
+func TestBindFlags(t *testing.T) {
+	t.Parallel()
+
+	newFlags := func(t *testing.T, source env.MapSource) (*flag.FlagSet, *bindFlagsTestConfig) {
+		t.Helper()
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+
+		config := &bindFlagsTestConfig{}
+		if err := env.New(source).BindFlags(fs, config); err != nil {
+			t.Fatalf("BindFlags() error = %v", err)
+		}
+		return fs, config
+	}
+
+	t.Run("uses the environment as defaults", func(t *testing.T) {
+		fs, config := newFlags(t, env.MapSource{"HTTP_PORT": "9090", "TAGS": "a,b"})
+		if err := fs.Parse(nil); err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+
+		if config.Port != 9090 || config.Timeout != 30*time.Second || strings.Join(config.Tags, "|") != "a|b" {
+			t.Errorf("config = %+v, want environment values and defaults", config)
+		}
+	})
+
+	t.Run("flags override the environment", func(t *testing.T) {
+		fs, config := newFlags(t, env.MapSource{"PORT": "9090", "DEBUG": "false"})
+
+		err := fs.Parse([]string{"-port", "7070", "-debug", "-wait=1m", "-tags", "x, y"})
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+
+		if config.Port != 7070 || !config.Debug || config.Timeout != time.Minute || strings.Join(config.Tags, "|") != "x|y" {
+			t.Errorf("config = %+v, want flag values", config)
+		}
+	})
+
+	t.Run("rejects malformed flags", func(t *testing.T) {
+		fs, _ := newFlags(t, env.MapSource{})
+		if err := fs.Parse([]string{"-port", "eighty"}); err == nil {
+			t.Errorf("Parse() error = nil, want error")
+		}
+	})
+
+	t.Run("skips fields tagged flag:\"-\"", func(t *testing.T) {
+		fs, _ := newFlags(t, env.MapSource{})
+		if fs.Lookup("internal") != nil {
+			t.Errorf("Lookup(internal) registered, want skipped")
+		}
+	})
+
+	t.Run("usage names the environment variables", func(t *testing.T) {
+		fs, _ := newFlags(t, env.MapSource{"DB_PASSWORD": "hunter2"})
+
+		var usage strings.Builder
+		fs.SetOutput(&usage)
+		fs.PrintDefaults()
+
+		for _, want := range []string{
+			"Port to listen on. (env PORT, HTTP_PORT) (default 8080)",
+			"(env DEBUG)",
+			"-wait value",
+			"Database password. (env DB_PASSWORD)\n",
+		} {
+			if !strings.Contains(usage.String(), want) {
+				t.Errorf("usage = %s\nwant it to contain %q", usage.String(), want)
+			}
+		}
+
+		if strings.Contains(usage.String(), "hunter2") {
+			t.Errorf("usage leaked a secret:\n%s", usage.String())
+		}
+	})
+
+	t.Run("usage names prefixed variables", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		app := env.New(env.MapSource{"APP_PORT": "9090"}).WithPrefix("APP_")
+		if err := app.BindFlags(fs, &bindFlagsTestConfig{}); err != nil {
+			t.Fatalf("BindFlags() error = %v", err)
+		}
+
+		if got, want := fs.Lookup("port").Usage, "Port to listen on. (env APP_PORT, APP_HTTP_PORT)"; got != want {
+			t.Errorf("Usage = %q, want %q", got, want)
+		}
+		if got := fs.Lookup("port").DefValue; got != "9090" {
+			t.Errorf("DefValue = %q, want %q", got, "9090")
+		}
+	})
+
+	t.Run("reports malformed variables", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		err := env.New(env.MapSource{"PORT": "eighty"}).BindFlags(fs, &bindFlagsTestConfig{})
+
+		var parseErr *env.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Key != "PORT" {
+			t.Errorf("BindFlags() error = %v, want *env.ParseError for PORT", err)
+		}
+	})
+
+	t.Run("allocates nested structs only when a flag is set", func(t *testing.T) {
+		var config struct {
+			Cache *bindTokenConfig `envPrefix:"CACHE_"`
+			Self  *bindNode
+		}
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		if err := env.New(env.MapSource{}).BindFlags(fs, &config); err != nil {
+			t.Fatalf("BindFlags() error = %v", err)
+		}
+		if config.Cache != nil {
+			t.Errorf("Cache = %+v before parsing, want nil", config.Cache)
+		}
+
+		if err := fs.Parse([]string{"-cache-token", "abc"}); err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if config.Cache == nil || config.Cache.Token != "abc" {
+			t.Errorf("Cache = %+v, want Token %q", config.Cache, "abc")
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package env_test
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+type bindDatabaseConfig struct {
+	Host string `env:"HOST" default:"localhost"`
+	Port int    `env:"PORT" default:"5432"`
+}
+
+type bindTestConfig struct {
+	DatabaseURL string              `env:"TEST_BIND_DATABASE_URL,TEST_BIND_DB_URL" required:"true"`
+	Port        int                 `env:"TEST_BIND_PORT" default:"8080"`
+	Debug       bool                `env:"TEST_BIND_DEBUG"`
+	Timeout     time.Duration       `env:"TEST_BIND_TIMEOUT" default:"30s"`
+	Ratio       *float64            `env:"TEST_BIND_RATIO"`
+	Untouched   string              `env:"TEST_BIND_UNTOUCHED"`
+	Ignored     string              `env:"-"`
+	Database    bindDatabaseConfig  `envPrefix:"TEST_BIND_DB_"`
+	Replica     *bindDatabaseConfig `envPrefix:"TEST_BIND_REPLICA_"`
+}
+
+type bindTokenConfig struct {
+	Token string `env:"TOKEN"`
+}
+
+type bindNode struct {
+	Name   string `env:"NAME"`
+	Parent *bindNode
+}
+
+func TestBind(t *testing.T) {
+	os.Setenv("TEST_BIND_DB_URL", "postgres://fallback")
+	defer os.Unsetenv("TEST_BIND_DB_URL")
+	os.Setenv("TEST_BIND_DEBUG", "true")
+	defer os.Unsetenv("TEST_BIND_DEBUG")
+	os.Setenv("TEST_BIND_RATIO", "0.5")
+	defer os.Unsetenv("TEST_BIND_RATIO")
+	os.Setenv("TEST_BIND_DB_HOST", "db.internal")
+	defer os.Unsetenv("TEST_BIND_DB_HOST")
+	os.Setenv("TEST_BIND_REPLICA_PORT", "6543")
+	defer os.Unsetenv("TEST_BIND_REPLICA_PORT")
+
+	config := bindTestConfig{Untouched: "preset", Ignored: "preset"}
+	if err := env.Bind(&config); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	t.Run("uses fallback keys", func(t *testing.T) {
+		if config.DatabaseURL != "postgres://fallback" {
+			t.Errorf("DatabaseURL = %v, want %v", config.DatabaseURL, "postgres://fallback")
+		}
+	})
+
+	t.Run("uses defaults", func(t *testing.T) {
+		if config.Port != 8080 {
+			t.Errorf("Port = %v, want %v", config.Port, 8080)
+		}
+		if config.Timeout != 30*time.Second {
+			t.Errorf("Timeout = %v, want %v", config.Timeout, 30*time.Second)
+		}
+	})
+
+	t.Run("parses values", func(t *testing.T) {
+		if config.Debug != true {
+			t.Errorf("Debug = %v, want %v", config.Debug, true)
+		}
+		if config.Ratio == nil || *config.Ratio != 0.5 {
+			t.Errorf("Ratio = %v, want %v", config.Ratio, 0.5)
+		}
+	})
+
+	t.Run("leaves unset fields without defaults alone", func(t *testing.T) {
+		if config.Untouched != "preset" {
+			t.Errorf("Untouched = %v, want %v", config.Untouched, "preset")
+		}
+		if config.Ignored != "preset" {
+			t.Errorf("Ignored = %v, want %v", config.Ignored, "preset")
+		}
+	})
+
+	t.Run("recurses into nested structs with prefixes", func(t *testing.T) {
+		if config.Database.Host != "db.internal" {
+			t.Errorf("Database.Host = %v, want %v", config.Database.Host, "db.internal")
+		}
+		if config.Database.Port != 5432 {
+			t.Errorf("Database.Port = %v, want %v", config.Database.Port, 5432)
+		}
+		if config.Replica == nil {
+			t.Fatalf("Replica = nil, want allocated struct")
+		}
+		if config.Replica.Host != "localhost" {
+			t.Errorf("Replica.Host = %v, want %v", config.Replica.Host, "localhost")
+		}
+		if config.Replica.Port != 6543 {
+			t.Errorf("Replica.Port = %v, want %v", config.Replica.Port, 6543)
+		}
+	})
+}
+
+func TestBind_Errors(t *testing.T) {
+	os.Setenv("TEST_BIND_PORT", "eighty")
+	defer os.Unsetenv("TEST_BIND_PORT")
+	os.Setenv("TEST_BIND_TIMEOUT", "soon")
+	defer os.Unsetenv("TEST_BIND_TIMEOUT")
+
+	var config bindTestConfig
+	err := env.Bind(&config)
+
+	var bindErr *env.BindError
+	if !errors.As(err, &bindErr) {
+		t.Fatalf("Bind() error = %v, want *env.BindError", err)
+	}
+
+	if len(bindErr.Errors) != 3 {
+		t.Fatalf("Bind() reported %d errors, want 3: %v", len(bindErr.Errors), err)
+	}
+
+	t.Run("reports missing required variables", func(t *testing.T) {
+		var missing *env.MissingError
+		if !errors.As(bindErr.Errors[0], &missing) {
+			t.Fatalf("Errors[0] = %v, want *env.MissingError", bindErr.Errors[0])
+		}
+		if missing.Keys[0] != "TEST_BIND_DATABASE_URL" || missing.Keys[1] != "TEST_BIND_DB_URL" {
+			t.Errorf("MissingError.Keys = %v", missing.Keys)
+		}
+	})
+
+	t.Run("reports malformed values", func(t *testing.T) {
+		for i, key := range []string{"TEST_BIND_PORT", "TEST_BIND_TIMEOUT"} {
+			var parseErr *env.ParseError
+			if !errors.As(bindErr.Errors[i+1], &parseErr) {
+				t.Fatalf("Errors[%d] = %v, want *env.ParseError", i+1, bindErr.Errors[i+1])
+			}
+			if parseErr.Key != key {
+				t.Errorf("ParseError.Key = %v, want %v", parseErr.Key, key)
+			}
+		}
+	})
+
+	t.Run("requires a struct pointer", func(t *testing.T) {
+		if err := env.Bind(config); err == nil {
+			t.Errorf("Bind(struct) error = nil, want error")
+		}
+
+		var nilConfig *bindTestConfig
+		if err := env.Bind(nilConfig); err == nil {
+			t.Errorf("Bind(nil) error = nil, want error")
+		}
+	})
+}
+
+func TestBind_Collections(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_BIND_ORIGINS": "https://a.com, https://b.com",
+		"TEST_BIND_PORTS":   "80;443",
+		"TEST_BIND_LABELS":  "team:core;tier:1",
+	})
+
+	var config struct {
+		Origins []string          `env:"TEST_BIND_ORIGINS"`
+		Ports   []int             `env:"TEST_BIND_PORTS" separator:";"`
+		Labels  map[string]string `env:"TEST_BIND_LABELS" separator:";" pairSeparator:":"`
+		Hosts   []string          `env:"TEST_BIND_HOSTS" default:"localhost,127.0.0.1"`
+	}
+
+	if err := env.Bind(&config); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	if want := []string{"https://a.com", "https://b.com"}; !reflect.DeepEqual(config.Origins, want) {
+		t.Errorf("Origins = %q, want %q", config.Origins, want)
+	}
+	if want := []int{80, 443}; !reflect.DeepEqual(config.Ports, want) {
+		t.Errorf("Ports = %v, want %v", config.Ports, want)
+	}
+	if want := map[string]string{"team": "core", "tier": "1"}; !reflect.DeepEqual(config.Labels, want) {
+		t.Errorf("Labels = %v, want %v", config.Labels, want)
+	}
+	if want := []string{"localhost", "127.0.0.1"}; !reflect.DeepEqual(config.Hosts, want) {
+		t.Errorf("Hosts = %q, want %q", config.Hosts, want)
+	}
+}
+
+func TestBind_NestedPointers(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		Logger *slog.Logger
+		Client *http.Client
+		Unset  *bindTokenConfig `envPrefix:"UNSET_"`
+		Set    *bindTokenConfig `envPrefix:"SET_"`
+	}
+
+	var c config
+	if err := env.New(env.MapSource{"SET_TOKEN": "abc"}).Bind(&c); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	if c.Logger != nil || c.Client != nil {
+		t.Errorf("Logger, Client = %v, %v, want nil pointers for structs without env fields", c.Logger, c.Client)
+	}
+	if c.Unset != nil {
+		t.Errorf("Unset = %+v, want nil when no field inside it is set", c.Unset)
+	}
+	if c.Set == nil || c.Set.Token != "abc" {
+		t.Errorf("Set = %+v, want Token %q", c.Set, "abc")
+	}
+}
+
+func TestBind_SelfReferentialType(t *testing.T) {
+	t.Parallel()
+
+	var node bindNode
+	if err := env.New(env.MapSource{"NAME": "root"}).Bind(&node); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	if node.Name != "root" || node.Parent != nil {
+		t.Errorf("Bind() = %+v, want Name %q and a nil Parent", node, "root")
+	}
+}
@@ -0,0 +1,17 @@
+package env
+
+import (
+	"reflect"
+)
+
+// Bool looks up key like Lookup and parses the value with boolable.Parse,
+// rejecting anything that is not an explicit true or false value.
+func Bool(key string, fallbacks ...string) (bool, error) {
+	return std.Bool(key, fallbacks...)
+}
+
+func (e *Env) Bool(key string, fallbacks ...string) (bool, error) {
+	var value bool
+	err := e.as(reflect.ValueOf(&value).Elem(), key, fallbacks)
+	return value, err
+}
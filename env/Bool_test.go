@@ -0,0 +1,50 @@
+package env_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestBool(t *testing.T) {
+	defer os.Unsetenv("TEST_BOOL_VALUE")
+
+	t.Run("true values", func(t *testing.T) {
+		for _, value := range []string{"1", "t", "true", "on", "y", "yes"} {
+			for _, cased := range []string{value, strings.ToUpper(value)} {
+				os.Setenv("TEST_BOOL_VALUE", cased)
+				if got, err := env.Bool("TEST_BOOL_VALUE"); err != nil || got != true {
+					t.Errorf("Bool(%q) = %v, %v, want true, nil", cased, got, err)
+				}
+			}
+		}
+	})
+
+	t.Run("false values", func(t *testing.T) {
+		for _, value := range []string{"", "0", "f", "false", "off", "n", "no"} {
+			for _, cased := range []string{value, strings.ToUpper(value)} {
+				os.Setenv("TEST_BOOL_VALUE", cased)
+				if got, err := env.Bool("TEST_BOOL_VALUE"); err != nil || got != false {
+					t.Errorf("Bool(%q) = %v, %v, want false, nil", cased, got, err)
+				}
+			}
+		}
+	})
+
+	t.Run("anything else is an error", func(t *testing.T) {
+		for _, value := range []string{"ture", "maybe", "2", " true", "yes please"} {
+			os.Setenv("TEST_BOOL_VALUE", value)
+			if got, err := env.Bool("TEST_BOOL_VALUE"); err == nil {
+				t.Errorf("Bool(%q) = %v, nil, want error", value, got)
+			}
+		}
+	})
+
+	t.Run("literal fallback", func(t *testing.T) {
+		if got, err := env.Bool("TEST_BOOL_NOT_EXISTS", "yes"); err != nil || got != true {
+			t.Errorf("Bool() = %v, %v, want true, nil", got, err)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package env
+
+type chain []Source
+
+func (c chain) Lookup(key string) (string, bool) {
+	for _, source := range c {
+		if value, exists := source.Lookup(key); exists {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Keys returns the keys of every source in the chain that implements Lister.
+func (c chain) Keys() []string {
+	seen := map[string]bool{}
+	var keys []string
+
+	for _, source := range c {
+		lister, ok := source.(Lister)
+		if !ok {
+			continue
+		}
+
+		for _, key := range lister.Keys() {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// Chain layers sources so that a key is read from the first source that has it.
+func Chain(sources ...Source) Source {
+	return chain(sources)
+}
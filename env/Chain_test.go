@@ -0,0 +1,40 @@
+package env_test
+
+import (
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestChain(t *testing.T) {
+	source := env.Chain(
+		env.MapSource{"OVERRIDE": "first", "EMPTY": ""},
+		env.MapSource{"OVERRIDE": "second", "EMPTY": "second", "BASE": "second"},
+	)
+
+	tests := []struct {
+		key        string
+		want       string
+		wantExists bool
+	}{
+		{"OVERRIDE", "first", true},
+		{"EMPTY", "", true},
+		{"BASE", "second", true},
+		{"MISSING", "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.key, func(t *testing.T) {
+			got, exists := source.Lookup(test.key)
+			if got != test.want || exists != test.wantExists {
+				t.Errorf("Lookup() = %v, %v, want %v, %v", got, exists, test.want, test.wantExists)
+			}
+		})
+	}
+
+	t.Run("empty chain", func(t *testing.T) {
+		if _, exists := env.Chain().Lookup("OVERRIDE"); exists {
+			t.Errorf("Lookup() exists = true, want false")
+		}
+	})
+}
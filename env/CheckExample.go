@@ -0,0 +1,65 @@
+package env
+
+import (
+	"strings"
+)
+
+// CheckExample compares the .env.example file at path with the struct pointed to by target,
+// returning a *DriftError listing variables missing from the file, variables the struct
+// does not bind, and values that differ from the struct's defaults. A fallback key
+// in the file counts as the variable it falls back for.
+// It is meant for tests, so CI fails when the example drifts from the code.
+func CheckExample(path string, target interface{}) error {
+	fields, err := fields(target)
+	if err != nil {
+		return err
+	}
+
+	entries, err := readDotenv(path)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(entries))
+	for _, e := range entries {
+		values[e.key] = e.value
+		if e.quote == '"' {
+			values[e.key] = strings.ReplaceAll(e.value, string(escapedDollar), "$")
+		}
+	}
+
+	drift := &DriftError{File: path}
+	known := map[string]bool{}
+
+	for _, f := range fields {
+		for _, key := range f.keys {
+			known[key] = true
+		}
+
+		key, value, found := f.keys[0], "", false
+		for _, k := range f.keys {
+			if value, found = values[k]; found {
+				key = k
+				break
+			}
+		}
+
+		if !found {
+			drift.Missing = append(drift.Missing, f.keys[0])
+		} else if def, _ := f.defaultValue(); value != def {
+			drift.Changed = append(drift.Changed, key)
+		}
+	}
+
+	for _, e := range entries {
+		if !known[e.key] {
+			known[e.key] = true
+			drift.Unexpected = append(drift.Unexpected, e.key)
+		}
+	}
+
+	if len(drift.Missing) > 0 || len(drift.Unexpected) > 0 || len(drift.Changed) > 0 {
+		return drift
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package env_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestCheckExample(t *testing.T) {
+	t.Run("accepts a generated example", func(t *testing.T) {
+		var b strings.Builder
+		if err := env.WriteExample(&b, &docsTestConfig{}); err != nil {
+			t.Fatalf("WriteExample() error = %v", err)
+		}
+
+		if err := env.CheckExample(writeFile(t, t.TempDir(), ".env", b.String()), &docsTestConfig{}); err != nil {
+			t.Errorf("CheckExample() error = %v", err)
+		}
+	})
+
+	t.Run("accepts fallback keys", func(t *testing.T) {
+		path := writeFile(t, t.TempDir(), ".env", "DB_URL=\nPORT=8080\nGREETING='hello, $USER #1'\nDEBUG=\nCACHE_TTL=5m\n")
+		if err := env.CheckExample(path, &docsTestConfig{}); err != nil {
+			t.Errorf("CheckExample() error = %v", err)
+		}
+	})
+
+	t.Run("reports drift", func(t *testing.T) {
+		path := writeFile(t, t.TempDir(), ".env", "DATABASE_URL=\nPORT=80\nDEBUG=\nOLD_SETTING=1\n")
+		err := env.CheckExample(path, &docsTestConfig{})
+
+		var drift *env.DriftError
+		if !errors.As(err, &drift) {
+			t.Fatalf("CheckExample() error = %v, want *env.DriftError", err)
+		}
+
+		if want := []string{"GREETING", "CACHE_TTL"}; !reflect.DeepEqual(drift.Missing, want) {
+			t.Errorf("Missing = %v, want %v", drift.Missing, want)
+		}
+		if want := []string{"OLD_SETTING"}; !reflect.DeepEqual(drift.Unexpected, want) {
+			t.Errorf("Unexpected = %v, want %v", drift.Unexpected, want)
+		}
+		if want := []string{"PORT"}; !reflect.DeepEqual(drift.Changed, want) {
+			t.Errorf("Changed = %v, want %v", drift.Changed, want)
+		}
+
+		if !strings.Contains(err.Error(), "missing GREETING") {
+			t.Errorf("Error() = %v, want it to list missing variables", err)
+		}
+	})
+
+	t.Run("reports unreadable files", func(t *testing.T) {
+		if err := env.CheckExample("does-not-exist.env", &docsTestConfig{}); err == nil {
+			t.Errorf("CheckExample() error = nil, want error")
+		}
+	})
+}
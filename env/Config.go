@@ -0,0 +1,102 @@
+package env
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sampson-golang/utilities/output"
+)
+
+// RedactPatterns lists the keys whose values Config redacts. Patterns match case-insensitively:
+// a plain pattern matches any key containing it, and a pattern with wildcards
+// must match the whole key, using path.Match syntax.
+var RedactPatterns = []string{"PASSWORD", "TOKEN", "SECRET", "*_KEY"}
+
+// Redacted replaces the value of redacted settings.
+const Redacted = "[REDACTED]"
+
+// Setting is a single variable of the effective configuration.
+type Setting struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Source   string `json:"source"`
+	Redacted bool   `json:"redacted,omitempty"`
+}
+
+// Config returns every key read through the package-level functions, in the order
+// the keys were first read, with the values of secrets redacted.
+func Config() []Setting {
+	return std.Config()
+}
+
+// Config returns every key read through this Env, and any Env derived from it with WithPrefix,
+// in the order the keys were first read. Values are redacted when any key consulted matches
+// RedactPatterns, was tagged `secret:"true"` in Bind, or was read with LookupSecret.
+// Empty values are never redacted, so unset secrets remain visible.
+func (e *Env) Config() []Setting {
+	trail := e.trail.list()
+	settings := make([]Setting, len(trail))
+
+	for i, result := range trail {
+		settings[i] = Setting{Key: result.Keys[0], Value: result.Value, Source: result.Source()}
+
+		if e.redacts(result) {
+			settings[i].Value = Redacted
+			settings[i].Redacted = true
+		}
+	}
+	return settings
+}
+
+// redacts reports whether the value of result must be hidden from Config, Trail and DumpTrail.
+func (e *Env) redacts(result Result) bool {
+	return result.Value != "" && (e.trail.secret(result.Keys) || redacts(result.Keys))
+}
+
+// ConfigJSON renders Config as indented JSON with output.Prettify.
+func ConfigJSON(indent ...string) string {
+	return std.ConfigJSON(indent...)
+}
+
+func (e *Env) ConfigJSON(indent ...string) string {
+	return output.Prettify(e.Config(), indent...)
+}
+
+// ConfigTable renders Config as an aligned table with KEY, VALUE and SOURCE columns.
+func ConfigTable() string {
+	return std.ConfigTable()
+}
+
+func (e *Env) ConfigTable() string {
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "KEY\tVALUE\tSOURCE")
+	for _, setting := range e.Config() {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", setting.Key, setting.Value, setting.Source)
+	}
+
+	w.Flush()
+	return b.String()
+}
+
+func redacts(keys []string) bool {
+	for _, key := range keys {
+		key = strings.ToUpper(key)
+
+		for _, pattern := range RedactPatterns {
+			pattern = strings.ToUpper(pattern)
+
+			if strings.ContainsAny(pattern, "*?[") {
+				if matched, _ := path.Match(pattern, key); matched {
+					return true
+				}
+			} else if strings.Contains(key, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
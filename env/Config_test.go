@@ -0,0 +1,110 @@
+package env_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+type configTestConfig struct {
+	URL      string `env:"DATABASE_URL" secret:"true"`
+	Port     int    `env:"PORT" default:"8080"`
+	Password string `env:"DB_PASSWORD"`
+}
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"DATABASE_URL":  "postgres://user:pass@db",
+		"DB_PASSWORD":   "hunter2",
+		"API_KEY":       "abc123",
+		"GITHUB_TOKEN":  "",
+		"KEYBOARD":      "qwerty",
+		"SIGNING_STUFF": "visible",
+	})
+
+	var config configTestConfig
+	if err := e.Bind(&config); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+	e.Get("API_KEY")
+	e.Get("GITHUB_TOKEN")
+	e.Get("KEYBOARD")
+	e.LookupSecret("SIGNING_STUFF")
+
+	t.Run("Config", func(t *testing.T) {
+		want := []env.Setting{
+			{Key: "DATABASE_URL", Value: env.Redacted, Source: "DATABASE_URL", Redacted: true},
+			{Key: "PORT", Value: "8080", Source: "default"},
+			{Key: "DB_PASSWORD", Value: env.Redacted, Source: "DB_PASSWORD", Redacted: true},
+			{Key: "API_KEY", Value: env.Redacted, Source: "API_KEY", Redacted: true},
+			{Key: "GITHUB_TOKEN", Value: "", Source: "GITHUB_TOKEN"},
+			{Key: "KEYBOARD", Value: "qwerty", Source: "KEYBOARD"},
+			{Key: "SIGNING_STUFF", Value: env.Redacted, Source: "SIGNING_STUFF", Redacted: true},
+		}
+
+		if got := e.Config(); !reflect.DeepEqual(got, want) {
+			t.Errorf("Config() = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("ConfigJSON", func(t *testing.T) {
+		got := e.ConfigJSON()
+		if strings.Contains(got, "hunter2") || strings.Contains(got, "abc123") {
+			t.Errorf("ConfigJSON() leaked a secret:\n%s", got)
+		}
+
+		want := "  {\n    \"key\": \"PORT\",\n    \"value\": \"8080\",\n    \"source\": \"default\"\n  }"
+		if !strings.Contains(got, want) {
+			t.Errorf("ConfigJSON() =\n%s\nwant it to contain\n%s", got, want)
+		}
+	})
+
+	t.Run("ConfigTable", func(t *testing.T) {
+		lines := strings.Split(e.ConfigTable(), "\n")
+
+		if lines[0] != "KEY            VALUE       SOURCE" {
+			t.Errorf("ConfigTable() header = %q", lines[0])
+		}
+		if lines[3] != "DB_PASSWORD    [REDACTED]  DB_PASSWORD" {
+			t.Errorf("ConfigTable() row = %q", lines[3])
+		}
+	})
+}
+
+func TestConfig_InternalLookups(t *testing.T) {
+	path := writeFile(t, t.TempDir(), ".env", "TEST_CONFIG_INTERNAL_URL=http://${TEST_CONFIG_INTERNAL_HOST}\nTEST_CONFIG_INTERNAL_HOST=db\n")
+	env.Sandbox(t, nil, "TEST_CONFIG_INTERNAL_URL", "TEST_CONFIG_INTERNAL_HOST")
+
+	if err := env.LoadFile(path, false); err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	if _, err := env.Expand("${TEST_CONFIG_INTERNAL_HOST}"); err != nil {
+		t.Fatalf("Expand() error = %v", err)
+	}
+
+	for _, result := range env.Trail() {
+		if strings.HasPrefix(result.Keys[0], "TEST_CONFIG_INTERNAL_") {
+			t.Errorf("Trail() recorded internal lookup of %s", result.Keys[0])
+		}
+	}
+}
+
+func TestConfig_SecretFile(t *testing.T) {
+	t.Parallel()
+
+	path := writeFile(t, t.TempDir(), "secret", "s3cr3t\n")
+	e := env.New(env.MapSource{"TOKEN_FILE": path})
+
+	if value, found, err := e.LookupSecret("TOKEN"); value != "s3cr3t" || !found || err != nil {
+		t.Fatalf("LookupSecret() = %q, %v, %v", value, found, err)
+	}
+
+	want := []env.Setting{{Key: "TOKEN", Value: env.Redacted, Source: "TOKEN_FILE", Redacted: true}}
+	if got := e.Config(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Config() = %+v, want %+v", got, want)
+	}
+}
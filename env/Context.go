@@ -0,0 +1,33 @@
+package env
+
+import (
+	"context"
+)
+
+type contextKey struct{}
+
+// WithSource returns a copy of ctx carrying source, which the Ctx variants of the lookup
+// functions consult before the process environment, so each tenant or job in a process
+// can see its own values. Sources attached to a context that already carries one
+// take precedence over it. Deprecated aliases declared with Deprecate still apply.
+func WithSource(ctx context.Context, source Source) context.Context {
+	parent := FromContext(ctx)
+
+	e := &Env{
+		source:       Chain(source, parent.source),
+		prefix:       parent.prefix,
+		trail:        newTrail(),
+		deprecations: parent.deprecations,
+	}
+	return context.WithValue(ctx, contextKey{}, e)
+}
+
+// FromContext returns the Env carried by ctx, which reads its sources before the
+// process environment, or the package-level Env if ctx carries no source.
+// Each context created by WithSource has its own Trail.
+func FromContext(ctx context.Context) *Env {
+	if e, ok := ctx.Value(contextKey{}).(*Env); ok {
+		return e
+	}
+	return std
+}
@@ -0,0 +1,77 @@
+package env_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestWithSource(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_CONTEXT_SHARED": "process",
+		"TEST_CONTEXT_TENANT": "process",
+		"TEST_CONTEXT_EMPTY":  "",
+	})
+
+	background := context.Background()
+	acme := env.WithSource(background, env.MapSource{"TEST_CONTEXT_TENANT": "acme"})
+	globex := env.WithSource(background, env.MapSource{"TEST_CONTEXT_TENANT": "globex", "TEST_CONTEXT_EMPTY": ""})
+
+	t.Run("consults the context first", func(t *testing.T) {
+		if got := env.GetCtx(acme, "TEST_CONTEXT_TENANT"); got != "acme" {
+			t.Errorf("GetCtx(acme) = %v, want %v", got, "acme")
+		}
+		if got := env.GetCtx(globex, "TEST_CONTEXT_TENANT"); got != "globex" {
+			t.Errorf("GetCtx(globex) = %v, want %v", got, "globex")
+		}
+	})
+
+	t.Run("falls through to the process environment", func(t *testing.T) {
+		got, exists := env.LookupCtx(acme, "TEST_CONTEXT_SHARED")
+		if got != "process" || !exists {
+			t.Errorf("LookupCtx() = %v, %v, want %v, %v", got, exists, "process", true)
+		}
+
+		if got := env.GetCtx(background, "TEST_CONTEXT_TENANT"); got != "process" {
+			t.Errorf("GetCtx(background) = %v, want %v", got, "process")
+		}
+	})
+
+	t.Run("follows the fallback chain", func(t *testing.T) {
+		got, exists := env.LookupCtx(acme, "TEST_CONTEXT_NOT_EXISTS", "TEST_CONTEXT_TENANT", "fallback")
+		if got != "acme" || !exists {
+			t.Errorf("LookupCtx() = %v, %v, want %v, %v", got, exists, "acme", true)
+		}
+
+		if got := env.GetPresentCtx(globex, "TEST_CONTEXT_EMPTY", "fallback"); got != "fallback" {
+			t.Errorf("GetPresentCtx() = %v, want %v", got, "fallback")
+		}
+		if _, exists := env.LookupPresentCtx(globex, "TEST_CONTEXT_EMPTY"); exists {
+			t.Errorf("LookupPresentCtx() exists = true, want false")
+		}
+		if !env.ExistsCtx(globex, "TEST_CONTEXT_EMPTY") {
+			t.Errorf("ExistsCtx() = false, want true")
+		}
+	})
+
+	t.Run("nested sources take precedence", func(t *testing.T) {
+		job := env.WithSource(acme, env.MapSource{"TEST_CONTEXT_SHARED": "job"})
+
+		if got := env.GetCtx(job, "TEST_CONTEXT_SHARED"); got != "job" {
+			t.Errorf("GetCtx(TEST_CONTEXT_SHARED) = %v, want %v", got, "job")
+		}
+		if got := env.GetCtx(job, "TEST_CONTEXT_TENANT"); got != "acme" {
+			t.Errorf("GetCtx(TEST_CONTEXT_TENANT) = %v, want %v", got, "acme")
+		}
+	})
+
+	t.Run("FromContext", func(t *testing.T) {
+		var config struct {
+			Tenant string `env:"TEST_CONTEXT_TENANT"`
+		}
+		if err := env.FromContext(globex).Bind(&config); err != nil || config.Tenant != "globex" {
+			t.Errorf("Bind() = %+v, %v, want Tenant %v", config, err, "globex")
+		}
+	})
+}
@@ -0,0 +1,28 @@
+package env
+
+// Deprecate declares key as a deprecated alias of replacement, to be removed in removal
+// (a version, date, or empty). Lookups of replacement that find it unset fall back to key,
+// and the first time key satisfies any lookup a structured warning is sent to the Logger.
+func Deprecate(key string, replacement string, removal string) {
+	std.Deprecate(key, replacement, removal)
+}
+
+// Deprecate declares a deprecated alias. On a prefixed Env, both keys are prefixed.
+// Deprecations are shared with every Env derived from this one by WithPrefix.
+func (e *Env) Deprecate(key string, replacement string, removal string) {
+	e.deprecations.add(deprecation{key: e.prefix + key, replacement: e.prefix + replacement, removal: removal})
+}
+
+// SetStrict controls whether deprecated variables are refused. In strict mode a set
+// deprecated variable is still warned about, but lookups treat it as unset, and
+// Bind, As, and the typed lookups report a *DeprecatedError.
+func SetStrict(strict bool) {
+	std.SetStrict(strict)
+}
+
+func (e *Env) SetStrict(strict bool) {
+	e.deprecations.mutex.Lock()
+	defer e.deprecations.mutex.Unlock()
+
+	e.deprecations.strict = strict
+}
@@ -0,0 +1,114 @@
+package env_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+// deprecateTestLogger records every warning it receives.
+type deprecateTestLogger struct {
+	mutex    sync.Mutex
+	warnings []string
+}
+
+func (l *deprecateTestLogger) Warn(msg string, args ...any) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.warnings = append(l.warnings, strings.TrimSpace(fmt.Sprintln(append([]any{msg}, args...)...)))
+}
+
+func TestDeprecate(t *testing.T) {
+	t.Parallel()
+
+	newEnv := func() (*env.Env, *deprecateTestLogger) {
+		e := env.New(env.MapSource{
+			"OLD_NAME":     "old",
+			"APP_OLD_PORT": "8080",
+			"BOTH_OLD":     "old",
+			"BOTH_NEW":     "new",
+		})
+		logger := &deprecateTestLogger{}
+		e.SetLogger(logger)
+		e.Deprecate("OLD_NAME", "NEW_NAME", "v2.0")
+		return e, logger
+	}
+
+	t.Run("resolves the replacement through the alias", func(t *testing.T) {
+		e, _ := newEnv()
+
+		result := e.LookupDetailed("NEW_NAME", "fallback")
+		if result.Value != "old" || result.Key != "OLD_NAME" || !result.Deprecated {
+			t.Errorf("LookupDetailed() = %+v, want value from deprecated OLD_NAME", result)
+		}
+		if want := []string{"NEW_NAME", "OLD_NAME"}; fmt.Sprint(result.Keys) != fmt.Sprint(want) {
+			t.Errorf("Keys = %v, want %v", result.Keys, want)
+		}
+	})
+
+	t.Run("prefers the replacement", func(t *testing.T) {
+		e, logger := newEnv()
+		e.Deprecate("BOTH_OLD", "BOTH_NEW", "")
+
+		if got := e.Get("BOTH_NEW"); got != "new" {
+			t.Errorf("Get() = %v, want %v", got, "new")
+		}
+		if len(logger.warnings) != 0 {
+			t.Errorf("warnings = %v, want none", logger.warnings)
+		}
+	})
+
+	t.Run("warns once", func(t *testing.T) {
+		e, logger := newEnv()
+
+		e.Get("NEW_NAME")
+		e.Get("NEW_NAME")
+		e.Get("OLD_NAME")
+
+		want := []string{"env: deprecated variable is set key OLD_NAME replacement NEW_NAME removal v2.0 strict false"}
+		if fmt.Sprint(logger.warnings) != fmt.Sprint(want) {
+			t.Errorf("warnings = %q, want %q", logger.warnings, want)
+		}
+	})
+
+	t.Run("prefixes keys", func(t *testing.T) {
+		e, _ := newEnv()
+		app := e.WithPrefix("APP_")
+		app.Deprecate("OLD_PORT", "PORT", "")
+
+		if got := app.Get("PORT"); got != "8080" {
+			t.Errorf("Get() = %v, want %v", got, "8080")
+		}
+	})
+
+	t.Run("strict mode refuses deprecated variables", func(t *testing.T) {
+		e, logger := newEnv()
+		e.SetStrict(true)
+
+		result := e.LookupDetailed("NEW_NAME", "fallback")
+		if result.Value != "fallback" || result.Found {
+			t.Errorf("LookupDetailed() = %+v, want the default", result)
+		}
+
+		var deprecated *env.DeprecatedError
+		if !errors.As(result.Err, &deprecated) || deprecated.Key != "OLD_NAME" || deprecated.Replacement != "NEW_NAME" {
+			t.Errorf("Err = %v, want *env.DeprecatedError for OLD_NAME", result.Err)
+		}
+
+		var config struct {
+			Name string `env:"NEW_NAME"`
+		}
+		if err := e.Bind(&config); !errors.As(err, &deprecated) {
+			t.Errorf("Bind() error = %v, want *env.DeprecatedError", err)
+		}
+
+		if len(logger.warnings) != 1 {
+			t.Errorf("warnings = %v, want 1", logger.warnings)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package env
+
+import (
+	"reflect"
+	"time"
+)
+
+// Duration looks up key like Lookup and parses the value with time.ParseDuration.
+func Duration(key string, fallbacks ...string) (time.Duration, error) {
+	return std.Duration(key, fallbacks...)
+}
+
+func (e *Env) Duration(key string, fallbacks ...string) (time.Duration, error) {
+	var value time.Duration
+	err := e.as(reflect.ValueOf(&value).Elem(), key, fallbacks)
+	return value, err
+}
@@ -0,0 +1,42 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestDuration(t *testing.T) {
+	os.Setenv("TEST_DURATION_VALUE", "1m30s")
+	defer os.Unsetenv("TEST_DURATION_VALUE")
+	os.Setenv("TEST_DURATION_BAD", "90")
+	defer os.Unsetenv("TEST_DURATION_BAD")
+
+	tests := []struct {
+		name      string
+		key       string
+		fallbacks []string
+		want      time.Duration
+		wantErr   bool
+	}{
+		{"duration", "TEST_DURATION_VALUE", nil, 90 * time.Second, false},
+		{"literal fallback", "TEST_DURATION_NOT_EXISTS", []string{"250ms"}, 250 * time.Millisecond, false},
+		{"env fallback", "TEST_DURATION_NOT_EXISTS", []string{"TEST_DURATION_VALUE", "1s"}, 90 * time.Second, false},
+		{"unset", "TEST_DURATION_NOT_EXISTS", nil, 0, false},
+		{"missing unit", "TEST_DURATION_BAD", nil, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := env.Duration(test.key, test.fallbacks...)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Duration() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("Duration() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package env
+
+// Env reads variables from a Source. Its methods behave exactly like the
+// package-level functions of the same name, which use an Env reading from OS.
+type Env struct {
+	source Source
+	prefix string
+	trail  *trail
+	// deprecations is shared with every Env derived by WithPrefix.
+	deprecations *deprecations
+}
+
+var std = New(OS)
+
+// New returns an Env reading from source, or from OS if source is nil.
+func New(source Source) *Env {
+	if source == nil {
+		source = OS
+	}
+	return &Env{source: source, trail: newTrail(), deprecations: newDeprecations()}
+}
@@ -0,0 +1,171 @@
+package env_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestEnv(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"EXISTS":   "value",
+		"EXISTS_2": "value_2",
+		"EMPTY":    "",
+		"PORT":     "9090",
+		"URL":      "http://${HOST:-localhost}:${PORT}",
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			key        string
+			fallbacks  []string
+			want       string
+			wantExists bool
+		}{
+			{"exists", "EXISTS", nil, "value", true},
+			{"does not exist", "NOT_EXISTS", nil, "", false},
+			{"literal fallback", "NOT_EXISTS", []string{"fallback"}, "fallback", false},
+			{"env fallback", "NOT_EXISTS", []string{"EXISTS_2", "fallback"}, "value_2", true},
+			{"empty fallback", "NOT_EXISTS", []string{"EMPTY", "fallback"}, "", true},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				got, gotExists := e.Lookup(test.key, test.fallbacks...)
+				if got != test.want || gotExists != test.wantExists {
+					t.Errorf("Lookup() = %v, %v, want %v, %v", got, gotExists, test.want, test.wantExists)
+				}
+
+				if got := e.Get(test.key, test.fallbacks...); got != test.want {
+					t.Errorf("Get() = %v, want %v", got, test.want)
+				}
+			})
+		}
+	})
+
+	t.Run("LookupPresent", func(t *testing.T) {
+		got, gotExists := e.LookupPresent("EMPTY", "EXISTS_2", "fallback")
+		if got != "value_2" || gotExists != true {
+			t.Errorf("LookupPresent() = %v, %v, want %v, %v", got, gotExists, "value_2", true)
+		}
+
+		got, gotExists = e.LookupPresent("EMPTY", "NOT_EXISTS", "fallback")
+		if got != "fallback" || gotExists != false {
+			t.Errorf("LookupPresent() = %v, %v, want %v, %v", got, gotExists, "fallback", false)
+		}
+
+		if got := e.GetPresent("EMPTY", "fallback"); got != "fallback" {
+			t.Errorf("GetPresent() = %v, want %v", got, "fallback")
+		}
+	})
+
+	t.Run("Exists", func(t *testing.T) {
+		if !e.Exists("EMPTY") {
+			t.Errorf("Exists(EMPTY) = false, want true")
+		}
+		if e.Exists("NOT_EXISTS") {
+			t.Errorf("Exists(NOT_EXISTS) = true, want false")
+		}
+	})
+
+	t.Run("Bind", func(t *testing.T) {
+		var config struct {
+			Port int    `env:"PORT"`
+			Name string `env:"NAME" default:"app"`
+		}
+
+		if err := e.Bind(&config); err != nil {
+			t.Fatalf("Bind() error = %v", err)
+		}
+		if config.Port != 9090 || config.Name != "app" {
+			t.Errorf("Bind() = %+v", config)
+		}
+	})
+
+	t.Run("Expand", func(t *testing.T) {
+		got, err := e.Expand("${URL}/${EXISTS}")
+		if err != nil || got != "http://${HOST:-localhost}:${PORT}/value" {
+			t.Errorf("Expand() = %q, %v", got, err)
+		}
+
+		expanded, err := e.ExpandMap(map[string]string{"URL": "http://${HOST:-localhost}:${PORT}"})
+		if err != nil || expanded["URL"] != "http://localhost:9090" {
+			t.Errorf("ExpandMap() = %v, %v", expanded, err)
+		}
+	})
+}
+
+func TestNew_NilSourceUsesOS(t *testing.T) {
+	t.Setenv("TEST_ENV_NEW_NIL", "from_os")
+
+	if got := env.New(nil).Get("TEST_ENV_NEW_NIL"); got != "from_os" {
+		t.Errorf("Get() = %v, want %v", got, "from_os")
+	}
+}
+
+func TestEnv_TypedLookups(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"PORT":     "9090",
+		"TIMEOUT":  "1m",
+		"RATIO":    "0.5",
+		"DEBUG":    "yes",
+		"STARTED":  "2024-01-02",
+		"BAD_URL":  "://missing-scheme",
+		"BAD_TIME": "yesterday",
+	})
+
+	t.Run("reads the Env", func(t *testing.T) {
+		if got, err := e.Int("MISSING", "PORT", "80"); got != 9090 || err != nil {
+			t.Errorf("Int() = %v, %v", got, err)
+		}
+		if got, err := e.Float("RATIO"); got != 0.5 || err != nil {
+			t.Errorf("Float() = %v, %v", got, err)
+		}
+		if got, err := e.Duration("TIMEOUT"); got != time.Minute || err != nil {
+			t.Errorf("Duration() = %v, %v", got, err)
+		}
+		if got, err := e.Bool("DEBUG"); !got || err != nil {
+			t.Errorf("Bool() = %v, %v", got, err)
+		}
+		if got, err := e.URL("MISSING", "http://localhost"); err != nil || got.Host != "localhost" {
+			t.Errorf("URL() = %v, %v", got, err)
+		}
+		if got, err := e.Time("2006-01-02", "STARTED"); err != nil || got.Year() != 2024 {
+			t.Errorf("Time() = %v, %v", got, err)
+		}
+	})
+
+	t.Run("names the fallback key", func(t *testing.T) {
+		var parseErr *env.ParseError
+
+		if _, err := e.URL("MISSING", "BAD_URL", ""); !errors.As(err, &parseErr) || parseErr.Key != "BAD_URL" {
+			t.Errorf("URL() error = %v, want a *ParseError for BAD_URL", err)
+		}
+		if _, err := e.Time("2006-01-02", "MISSING", "BAD_TIME", ""); !errors.As(err, &parseErr) || parseErr.Key != "BAD_TIME" {
+			t.Errorf("Time() error = %v, want a *ParseError for BAD_TIME", err)
+		}
+	})
+
+	t.Run("refuses strict deprecations", func(t *testing.T) {
+		e := env.New(env.MapSource{"OLD_URL": "http://old", "OLD_STARTED": "2024-01-02"})
+		e.SetLogger(&deprecateTestLogger{})
+		e.Deprecate("OLD_URL", "NEW_URL", "")
+		e.Deprecate("OLD_STARTED", "NEW_STARTED", "")
+		e.SetStrict(true)
+
+		var deprecatedErr *env.DeprecatedError
+		if got, err := e.URL("NEW_URL"); !errors.As(err, &deprecatedErr) {
+			t.Errorf("URL() = %v, %v, want a *DeprecatedError", got, err)
+		}
+		if got, err := e.Time("2006-01-02", "NEW_STARTED"); !errors.As(err, &deprecatedErr) {
+			t.Errorf("Time() = %v, %v, want a *DeprecatedError", got, err)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package env
+
+import (
+	"strings"
+)
+
+// Environ returns every variable in the environment as a map.
+func Environ() map[string]string {
+	return std.Environ()
+}
+
+// Environ returns every variable under the Env's prefix, keyed with the prefix stripped.
+// Sources that do not implement Lister contribute no variables.
+func (e *Env) Environ() map[string]string {
+	values := map[string]string{}
+
+	lister, ok := e.source.(Lister)
+	if !ok {
+		return values
+	}
+
+	for _, key := range lister.Keys() {
+		name, found := strings.CutPrefix(key, e.prefix)
+		if !found || name == "" {
+			continue
+		}
+
+		if value, exists := e.source.Lookup(key); exists {
+			values[name] = value
+		}
+	}
+	return values
+}
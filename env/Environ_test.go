@@ -0,0 +1,62 @@
+package env_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+type lookupOnlySource struct{}
+
+func (lookupOnlySource) Lookup(key string) (string, bool) {
+	return "value", true
+}
+
+func TestEnviron(t *testing.T) {
+	os.Setenv("TEST_ENVIRON_A", "a")
+	defer os.Unsetenv("TEST_ENVIRON_A")
+	os.Setenv("TEST_ENVIRON_EMPTY", "")
+	defer os.Unsetenv("TEST_ENVIRON_EMPTY")
+
+	t.Run("process environment", func(t *testing.T) {
+		values := env.Environ()
+		if value, exists := values["TEST_ENVIRON_A"]; value != "a" || !exists {
+			t.Errorf("Environ()[TEST_ENVIRON_A] = %v, %v, want %v, %v", value, exists, "a", true)
+		}
+		if value, exists := values["TEST_ENVIRON_EMPTY"]; value != "" || !exists {
+			t.Errorf("Environ()[TEST_ENVIRON_EMPTY] = %v, %v, want %v, %v", value, exists, "", true)
+		}
+	})
+
+	t.Run("strips the prefix", func(t *testing.T) {
+		got := env.WithPrefix("TEST_ENVIRON_").Environ()
+		want := map[string]string{"A": "a", "EMPTY": ""}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Environ() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("chained sources respect precedence", func(t *testing.T) {
+		e := env.New(env.Chain(
+			env.MapSource{"APP_HOST": "override"},
+			env.MapSource{"APP_HOST": "base", "APP_PORT": "8080", "OTHER": "ignored"},
+			lookupOnlySource{},
+		)).WithPrefix("APP_")
+
+		got := e.Environ()
+		want := map[string]string{"HOST": "override", "PORT": "8080"}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Environ() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("sources without Lister are empty", func(t *testing.T) {
+		if got := env.New(lookupOnlySource{}).Environ(); len(got) != 0 {
+			t.Errorf("Environ() = %v, want empty", got)
+		}
+	})
+}
@@ -0,0 +1,168 @@
+package env
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError reports an environment value that could not be converted to the requested type.
+type ParseError struct {
+	Key   string
+	Value string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("env: invalid value %q for %s: %v", e.Value, e.Key, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// MissingError reports a required variable that was not set under any of its keys.
+type MissingError struct {
+	Keys []string
+}
+
+func (e *MissingError) Error() string {
+	if len(e.Keys) > 1 {
+		return fmt.Sprintf("env: required variable %s (or %s) is not set", e.Keys[0], strings.Join(e.Keys[1:], ", "))
+	}
+	return fmt.Sprintf("env: required variable %s is not set", e.Keys[0])
+}
+
+// DeprecatedError reports a deprecated variable that was refused in strict mode.
+type DeprecatedError struct {
+	Key         string
+	Replacement string
+	Removal     string
+}
+
+func (e *DeprecatedError) Error() string {
+	if e.Removal != "" {
+		return fmt.Sprintf("env: %s is deprecated and will be removed in %s, use %s instead", e.Key, e.Removal, e.Replacement)
+	}
+	return fmt.Sprintf("env: %s is deprecated, use %s instead", e.Key, e.Replacement)
+}
+
+// BindError aggregates every error found while binding a struct.
+type BindError struct {
+	Errors []error
+}
+
+func (e *BindError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *BindError) Unwrap() []error {
+	return e.Errors
+}
+
+// SyntaxError reports malformed dotenv content.
+type SyntaxError struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.File != "" {
+		return fmt.Sprintf("env: %s:%d: %s", e.File, e.Line, e.Msg)
+	}
+	return fmt.Sprintf("env: line %d: %s", e.Line, e.Msg)
+}
+
+// ExpandError reports a ${VAR} reference that could not be expanded:
+// a ${VAR:?message} whose variable is unset, a reference cycle, or malformed syntax.
+type ExpandError struct {
+	Name string
+	Msg  string
+}
+
+func (e *ExpandError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("env: %s: %s", e.Name, e.Msg)
+	}
+	return "env: " + e.Msg
+}
+
+// ReferenceError reports a reference that could not be expanded in a JSON document.
+type ReferenceError struct {
+	// Path locates the string in the document, such as $.servers[0].url.
+	Path string
+	Name string
+	Msg  string
+}
+
+func (e *ReferenceError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("env: %s: %s: %s", e.Path, e.Name, e.Msg)
+	}
+	return fmt.Sprintf("env: %s: %s", e.Path, e.Msg)
+}
+
+// JSONError aggregates every reference that could not be expanded in a JSON document.
+type JSONError struct {
+	Errors []*ReferenceError
+}
+
+func (e *JSONError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e *JSONError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// SecretError reports a KEY_FILE variable whose file could not be read.
+type SecretError struct {
+	Key  string
+	Path string
+	Err  error
+}
+
+func (e *SecretError) Error() string {
+	return fmt.Sprintf("env: reading secret file %s from %s: %v", e.Path, e.Key, e.Err)
+}
+
+func (e *SecretError) Unwrap() error {
+	return e.Err
+}
+
+// DriftError reports differences between a .env.example file and the struct it documents.
+type DriftError struct {
+	File string
+	// Missing lists variables bound by the struct but absent from the file.
+	Missing []string
+	// Unexpected lists variables in the file that the struct does not bind.
+	Unexpected []string
+	// Changed lists variables whose value in the file differs from the struct's default.
+	Changed []string
+}
+
+func (e *DriftError) Error() string {
+	lines := []string{fmt.Sprintf("env: %s is out of date with its struct:", e.File)}
+	for _, key := range e.Missing {
+		lines = append(lines, "  missing "+key)
+	}
+	for _, key := range e.Unexpected {
+		lines = append(lines, "  unexpected "+key)
+	}
+	for _, key := range e.Changed {
+		lines = append(lines, "  changed default of "+key)
+	}
+	return strings.Join(lines, "\n")
+}
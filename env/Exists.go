@@ -1,6 +1,19 @@
 package env
 
+import (
+	"context"
+)
+
 func Exists(key string) bool {
-	_, exists := Lookup(key)
+	return std.Exists(key)
+}
+
+// ExistsCtx is Exists, consulting any Source attached to ctx with WithSource first.
+func ExistsCtx(ctx context.Context, key string) bool {
+	return FromContext(ctx).Exists(key)
+}
+
+func (e *Env) Exists(key string) bool {
+	_, exists := e.Lookup(key)
 	return exists
 }
@@ -0,0 +1,19 @@
+package env
+
+// Expand replaces $VAR and ${VAR} references in s with values from the environment.
+// It supports the POSIX forms ${VAR:-default}, ${VAR-default}, ${VAR:=default},
+// ${VAR:+alternate}, ${VAR+alternate}, ${VAR:?message} and ${VAR?message},
+// whose words may themselves contain references. \$ produces a literal $.
+func Expand(s string) (string, error) {
+	return std.Expand(s)
+}
+
+func (e *Env) Expand(s string) (string, error) {
+	return newExpander(nil, nil, e.lookupVariable).expand(s)
+}
+
+// lookupVariable resolves references for expansion. It reads the source directly,
+// so the package's own expansions are not recorded in the trail.
+func (e *Env) lookupVariable(name string) (string, bool) {
+	return e.source.Lookup(e.prefix + name)
+}
@@ -0,0 +1,84 @@
+package env
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// ExpandJSON expands $VAR and ${VAR} references, like Expand, in every string of a decoded
+// JSON document: the map[string]interface{} and []interface{} shapes produced by
+// encoding/json and understood by container.Dig. Object keys and non-string values are kept.
+//
+// It returns an expanded copy, leaving doc untouched. References to unset variables
+// without a default, and references that fail to expand, are reported together in
+// a *JSONError locating each by its JSON path; the copy is still returned, with
+// unset variables expanded to "" and failed strings left as they were.
+func ExpandJSON(doc interface{}) (interface{}, error) {
+	return std.ExpandJSON(doc)
+}
+
+func (e *Env) ExpandJSON(doc interface{}) (interface{}, error) {
+	var errs []*ReferenceError
+	expanded := e.expandJSON(doc, "$", &errs)
+
+	if len(errs) > 0 {
+		return expanded, &JSONError{Errors: errs}
+	}
+	return expanded, nil
+}
+
+func (e *Env) expandJSON(value interface{}, path string, errs *[]*ReferenceError) interface{} {
+	switch v := value.(type) {
+	case string:
+		x := newExpander(nil, nil, e.lookupVariable)
+
+		expanded, err := x.expand(v)
+		if err != nil {
+			var expandErr *ExpandError
+			if errors.As(err, &expandErr) {
+				*errs = append(*errs, &ReferenceError{Path: path, Name: expandErr.Name, Msg: expandErr.Msg})
+			} else {
+				*errs = append(*errs, &ReferenceError{Path: path, Msg: err.Error()})
+			}
+			return v
+		}
+
+		for _, name := range x.unresolved {
+			*errs = append(*errs, &ReferenceError{Path: path, Name: name, Msg: "not set"})
+		}
+		return expanded
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		expanded := make(map[string]interface{}, len(v))
+		for _, key := range keys {
+			expanded[key] = e.expandJSON(v[key], path+jsonPathKey(key), errs)
+		}
+		return expanded
+	case []interface{}:
+		expanded := make([]interface{}, len(v))
+		for i, element := range v {
+			expanded[i] = e.expandJSON(element, path+"["+strconv.Itoa(i)+"]", errs)
+		}
+		return expanded
+	default:
+		return value
+	}
+}
+
+var jsonPathIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// jsonPathKey formats an object key as a JSON path segment: .key, or ["key"] when
+// the key is not a plain identifier.
+func jsonPathKey(key string) string {
+	if jsonPathIdentifier.MatchString(key) {
+		return "." + key
+	}
+	return "[" + strconv.Quote(key) + "]"
+}
@@ -0,0 +1,102 @@
+package env_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestExpandJSON(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"HOST": "db.internal",
+		"PORT": "5432",
+	})
+
+	decode := func(t *testing.T, data string) interface{} {
+		t.Helper()
+
+		var doc interface{}
+		if err := json.Unmarshal([]byte(data), &doc); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		return doc
+	}
+
+	t.Run("expands every string", func(t *testing.T) {
+		doc := decode(t, `{
+			"database": {"url": "postgres://${HOST}:$PORT/app", "pool": 5},
+			"replicas": ["${HOST}", "${REPLICA:-backup.internal}"],
+			"${HOST}": true,
+			"nothing": null
+		}`)
+
+		got, err := e.ExpandJSON(doc)
+		if err != nil {
+			t.Fatalf("ExpandJSON() error = %v", err)
+		}
+
+		want := decode(t, `{
+			"database": {"url": "postgres://db.internal:5432/app", "pool": 5},
+			"replicas": ["db.internal", "backup.internal"],
+			"${HOST}": true,
+			"nothing": null
+		}`)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ExpandJSON() = %v, want %v", got, want)
+		}
+
+		if url := doc.(map[string]interface{})["database"].(map[string]interface{})["url"]; url != "postgres://${HOST}:$PORT/app" {
+			t.Errorf("ExpandJSON() modified its input: url = %v", url)
+		}
+	})
+
+	t.Run("reports unresolved references with their path", func(t *testing.T) {
+		doc := decode(t, `{
+			"servers": [{"url": "https://${API_HOST}"}, {"url": "${HOST}"}],
+			"auth": {"api-key": "$API_KEY", "token": "${TOKEN:?token is required}"}
+		}`)
+
+		got, err := e.ExpandJSON(doc)
+
+		var jsonErr *env.JSONError
+		if !errors.As(err, &jsonErr) {
+			t.Fatalf("ExpandJSON() error = %v, want *env.JSONError", err)
+		}
+
+		want := []env.ReferenceError{
+			{Path: `$.auth["api-key"]`, Name: "API_KEY", Msg: "not set"},
+			{Path: "$.auth.token", Name: "TOKEN", Msg: "token is required"},
+			{Path: "$.servers[0].url", Name: "API_HOST", Msg: "not set"},
+		}
+		if len(jsonErr.Errors) != len(want) {
+			t.Fatalf("ExpandJSON() reported %d errors, want %d: %v", len(jsonErr.Errors), len(want), err)
+		}
+		for i := range want {
+			if *jsonErr.Errors[i] != want[i] {
+				t.Errorf("Errors[%d] = %+v, want %+v", i, *jsonErr.Errors[i], want[i])
+			}
+		}
+
+		if jsonErr.Errors[0].Error() != `env: $.auth["api-key"]: API_KEY: not set` {
+			t.Errorf("Error() = %v", jsonErr.Errors[0].Error())
+		}
+
+		servers := got.(map[string]interface{})["servers"].([]interface{})
+		if url := servers[1].(map[string]interface{})["url"]; url != "db.internal" {
+			t.Errorf("servers[1].url = %v, want %v", url, "db.internal")
+		}
+	})
+
+	t.Run("keeps other values", func(t *testing.T) {
+		for _, doc := range []interface{}{nil, 1.5, true, map[string]int{"a": 1}} {
+			if got, err := e.ExpandJSON(doc); err != nil || !reflect.DeepEqual(got, doc) {
+				t.Errorf("ExpandJSON(%v) = %v, %v, want unchanged", doc, got, err)
+			}
+		}
+	})
+}
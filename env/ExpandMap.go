@@ -0,0 +1,27 @@
+package env
+
+// ExpandMap expands references in every value of vars, like Expand.
+// Values may refer to other keys in vars, in any order, before falling back to the environment.
+// A value referring to its own key sees the environment's value; longer reference cycles are errors.
+func ExpandMap(vars map[string]string) (map[string]string, error) {
+	return std.ExpandMap(vars)
+}
+
+func (e *Env) ExpandMap(vars map[string]string) (map[string]string, error) {
+	return e.expandAll(vars, nil, nil)
+}
+
+func (e *Env) expandAll(vars map[string]string, literal map[string]bool, quoted map[string]bool) (map[string]string, error) {
+	x := newExpander(vars, literal, e.lookupVariable)
+	x.quoted = quoted
+
+	expanded := make(map[string]string, len(vars))
+	for key := range vars {
+		value, _, err := x.resolve(key)
+		if err != nil {
+			return nil, err
+		}
+		expanded[key] = value
+	}
+	return expanded, nil
+}
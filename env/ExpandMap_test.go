@@ -0,0 +1,71 @@
+package env_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestExpandMap(t *testing.T) {
+	os.Setenv("TEST_EXPANDMAP_PATH", "/usr/bin")
+	defer os.Unsetenv("TEST_EXPANDMAP_PATH")
+
+	t.Run("values reference each other in any order", func(t *testing.T) {
+		got, err := env.ExpandMap(map[string]string{
+			"DATABASE_URL": "postgres://${DB_HOST}:${DB_PORT:-5432}/${DB_NAME}",
+			"DB_HOST":      "${HOST}",
+			"HOST":         "db.internal",
+			"DB_NAME":      "app",
+		})
+		if err != nil {
+			t.Fatalf("ExpandMap() error = %v", err)
+		}
+
+		if got["DATABASE_URL"] != "postgres://db.internal:5432/app" {
+			t.Errorf("ExpandMap()[DATABASE_URL] = %q", got["DATABASE_URL"])
+		}
+		if got["DB_HOST"] != "db.internal" {
+			t.Errorf("ExpandMap()[DB_HOST] = %q", got["DB_HOST"])
+		}
+	})
+
+	t.Run("falls back to the environment", func(t *testing.T) {
+		got, err := env.ExpandMap(map[string]string{"TEST_EXPANDMAP_PATH": "${TEST_EXPANDMAP_PATH}:/opt/bin"})
+		if err != nil {
+			t.Fatalf("ExpandMap() error = %v", err)
+		}
+
+		if got["TEST_EXPANDMAP_PATH"] != "/usr/bin:/opt/bin" {
+			t.Errorf("ExpandMap()[TEST_EXPANDMAP_PATH] = %q, want %q", got["TEST_EXPANDMAP_PATH"], "/usr/bin:/opt/bin")
+		}
+	})
+
+	t.Run("detects cycles", func(t *testing.T) {
+		_, err := env.ExpandMap(map[string]string{
+			"A": "${B}",
+			"B": "x${C:-${A}}",
+			"C": "",
+		})
+
+		var expandErr *env.ExpandError
+		if !errors.As(err, &expandErr) {
+			t.Fatalf("ExpandMap() error = %v, want *env.ExpandError", err)
+		}
+		if !strings.Contains(err.Error(), "reference cycle") {
+			t.Errorf("ExpandMap() error = %v, want reference cycle", err)
+		}
+	})
+
+	t.Run("does not modify its input", func(t *testing.T) {
+		vars := map[string]string{"A": "${B}", "B": "b"}
+		if _, err := env.ExpandMap(vars); err != nil {
+			t.Fatalf("ExpandMap() error = %v", err)
+		}
+		if vars["A"] != "${B}" {
+			t.Errorf("vars[A] = %q, want %q", vars["A"], "${B}")
+		}
+	})
+}
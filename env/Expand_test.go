@@ -0,0 +1,105 @@
+package env_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestExpand(t *testing.T) {
+	os.Setenv("TEST_EXPAND_HOST", "db.internal")
+	defer os.Unsetenv("TEST_EXPAND_HOST")
+	os.Setenv("TEST_EXPAND_PORT", "5432")
+	defer os.Unsetenv("TEST_EXPAND_PORT")
+	os.Setenv("TEST_EXPAND_EMPTY", "")
+	defer os.Unsetenv("TEST_EXPAND_EMPTY")
+	os.Setenv("TEST_EXPAND_REF", "${TEST_EXPAND_HOST}")
+	defer os.Unsetenv("TEST_EXPAND_REF")
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"plain text", "no references", "no references"},
+		{"braced", "postgres://${TEST_EXPAND_HOST}:${TEST_EXPAND_PORT}/app", "postgres://db.internal:5432/app"},
+		{"unbraced", "$TEST_EXPAND_HOST:$TEST_EXPAND_PORT", "db.internal:5432"},
+		{"unset is empty", "[${TEST_EXPAND_NOT_EXISTS}]", "[]"},
+		{"escaped", `\$TEST_EXPAND_HOST costs \$5`, "$TEST_EXPAND_HOST costs $5"},
+		{"environment values are not expanded", "${TEST_EXPAND_REF}", "${TEST_EXPAND_HOST}"},
+
+		{"default when unset", "${TEST_EXPAND_NOT_EXISTS:-fallback}", "fallback"},
+		{"default when empty", "${TEST_EXPAND_EMPTY:-fallback}", "fallback"},
+		{"default not used when set", "${TEST_EXPAND_HOST:-fallback}", "db.internal"},
+		{"unset-only default when unset", "${TEST_EXPAND_NOT_EXISTS-fallback}", "fallback"},
+		{"unset-only default when empty", "${TEST_EXPAND_EMPTY-fallback}", ""},
+		{"assign default", "${TEST_EXPAND_NOT_EXISTS:=fallback}/${TEST_EXPAND_NOT_EXISTS}", "fallback/fallback"},
+
+		{"alternate when set", "${TEST_EXPAND_HOST:+alternate}", "alternate"},
+		{"alternate when empty", "${TEST_EXPAND_EMPTY:+alternate}", ""},
+		{"unset-only alternate when empty", "${TEST_EXPAND_EMPTY+alternate}", "alternate"},
+		{"alternate when unset", "${TEST_EXPAND_NOT_EXISTS+alternate}", ""},
+
+		{"required when set", "${TEST_EXPAND_HOST:?must be set}", "db.internal"},
+
+		{"nested default", "${TEST_EXPAND_NOT_EXISTS:-${TEST_EXPAND_NOT_EXISTS_2:-${TEST_EXPAND_PORT}}}", "5432"},
+		{"nested alternate", "${TEST_EXPAND_HOST:+host=${TEST_EXPAND_HOST}}", "host=db.internal"},
+		{"default with braces", "${TEST_EXPAND_NOT_EXISTS:-{json\\}}", "{json\\}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := env.Expand(test.input)
+			if err != nil {
+				t.Fatalf("Expand(%q) error = %v", test.input, err)
+			}
+			if got != test.want {
+				t.Errorf("Expand(%q) got = %q, want %q", test.input, got, test.want)
+			}
+		})
+	}
+
+	t.Run("literal dollars", func(t *testing.T) {
+		got, err := env.Expand("$ and $1 and 100$")
+		if err != nil || got != "$ and $1 and 100$" {
+			t.Errorf("Expand() = %q, %v, want %q, nil", got, err, "$ and $1 and 100$")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			want  string
+		}{
+			{"required with message", "${TEST_EXPAND_NOT_EXISTS:?database host is required}", "env: TEST_EXPAND_NOT_EXISTS: database host is required"},
+			{"required when empty", "${TEST_EXPAND_EMPTY:?}", "env: TEST_EXPAND_EMPTY: not set"},
+			{"unset-only required", "${TEST_EXPAND_NOT_EXISTS?}", "env: TEST_EXPAND_NOT_EXISTS: not set"},
+			{"unterminated", "${TEST_EXPAND_HOST", "env: unterminated ${ in ${TEST_EXPAND_HOST"},
+			{"invalid name", "${1BAD}", "env: invalid reference ${1BAD}"},
+			{"invalid operator", "${TEST_EXPAND_HOST#prefix}", "env: TEST_EXPAND_HOST: invalid reference ${TEST_EXPAND_HOST#prefix}"},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := env.Expand(test.input)
+
+				var expandErr *env.ExpandError
+				if !errors.As(err, &expandErr) {
+					t.Fatalf("Expand(%q) error = %v, want *env.ExpandError", test.input, err)
+				}
+				if err.Error() != test.want {
+					t.Errorf("Expand(%q) error = %q, want %q", test.input, err.Error(), test.want)
+				}
+			})
+		}
+
+		t.Run("required is not checked when a default is used", func(t *testing.T) {
+			if _, err := env.Expand("${TEST_EXPAND_HOST:-${TEST_EXPAND_NOT_EXISTS:?unused}}"); err != nil {
+				t.Errorf("Expand() error = %v, want nil", err)
+			}
+		})
+	})
+}
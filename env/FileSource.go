@@ -0,0 +1,26 @@
+package env
+
+import (
+	"errors"
+	"os"
+)
+
+// FileSource parses the dotenv file at path into a MapSource, without
+// touching the process environment. Values are expanded like Parse.
+func FileSource(path string) (MapSource, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values, err := Parse(file)
+	if err != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			syntaxErr.File = path
+		}
+		return nil, err
+	}
+	return MapSource(values), nil
+}
@@ -0,0 +1,43 @@
+package env_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestFileSource(t *testing.T) {
+	path := writeFile(t, t.TempDir(), ".env", "TEST_FILESOURCE_HOST=localhost\nTEST_FILESOURCE_URL=http://${TEST_FILESOURCE_HOST}\n")
+
+	source, err := env.FileSource(path)
+	if err != nil {
+		t.Fatalf("FileSource() error = %v", err)
+	}
+
+	if got := env.New(source).Get("TEST_FILESOURCE_URL"); got != "http://localhost" {
+		t.Errorf("Get() = %v, want %v", got, "http://localhost")
+	}
+
+	if env.Exists("TEST_FILESOURCE_HOST") {
+		t.Errorf("FileSource() modified the process environment")
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := env.FileSource(filepath.Join(t.TempDir(), "missing.env"))
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("FileSource() error = %v, want os.ErrNotExist", err)
+		}
+	})
+
+	t.Run("syntax errors name the file", func(t *testing.T) {
+		badPath := writeFile(t, t.TempDir(), ".env", "INVALID\n")
+
+		var syntaxErr *env.SyntaxError
+		if _, err := env.FileSource(badPath); !errors.As(err, &syntaxErr) || syntaxErr.File != badPath {
+			t.Errorf("FileSource() error = %v, want *env.SyntaxError for %s", err, badPath)
+		}
+	})
+}
@@ -0,0 +1,16 @@
+package env
+
+import (
+	"reflect"
+)
+
+// Float looks up key like Lookup and parses the value as a float64.
+func Float(key string, fallbacks ...string) (float64, error) {
+	return std.Float(key, fallbacks...)
+}
+
+func (e *Env) Float(key string, fallbacks ...string) (float64, error) {
+	var value float64
+	err := e.as(reflect.ValueOf(&value).Elem(), key, fallbacks)
+	return value, err
+}
@@ -0,0 +1,41 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestFloat(t *testing.T) {
+	os.Setenv("TEST_FLOAT_VALUE", "0.25")
+	defer os.Unsetenv("TEST_FLOAT_VALUE")
+	os.Setenv("TEST_FLOAT_BAD", "quarter")
+	defer os.Unsetenv("TEST_FLOAT_BAD")
+
+	tests := []struct {
+		name      string
+		key       string
+		fallbacks []string
+		want      float64
+		wantErr   bool
+	}{
+		{"decimal", "TEST_FLOAT_VALUE", nil, 0.25, false},
+		{"literal fallback", "TEST_FLOAT_NOT_EXISTS", []string{"1e3"}, 1000, false},
+		{"env fallback", "TEST_FLOAT_NOT_EXISTS", []string{"TEST_FLOAT_VALUE", "1"}, 0.25, false},
+		{"unset", "TEST_FLOAT_NOT_EXISTS", nil, 0, false},
+		{"malformed", "TEST_FLOAT_BAD", nil, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := env.Float(test.key, test.fallbacks...)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Float() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("Float() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,19 @@
 package env
 
+import (
+	"context"
+)
+
 func Get(key string, fallbacks ...string) string {
-	value, _ := Lookup(key, fallbacks...)
+	return std.Get(key, fallbacks...)
+}
+
+// GetCtx is Get, consulting any Source attached to ctx with WithSource first.
+func GetCtx(ctx context.Context, key string, fallbacks ...string) string {
+	return FromContext(ctx).Get(key, fallbacks...)
+}
+
+func (e *Env) Get(key string, fallbacks ...string) string {
+	value, _ := e.Lookup(key, fallbacks...)
 	return value
 }
@@ -1,6 +1,19 @@
 package env
 
+import (
+	"context"
+)
+
 func GetPresent(key string, fallbacks ...string) string {
-	value, _ := LookupPresent(key, fallbacks...)
+	return std.GetPresent(key, fallbacks...)
+}
+
+// GetPresentCtx is GetPresent, consulting any Source attached to ctx with WithSource first.
+func GetPresentCtx(ctx context.Context, key string, fallbacks ...string) string {
+	return FromContext(ctx).GetPresent(key, fallbacks...)
+}
+
+func (e *Env) GetPresent(key string, fallbacks ...string) string {
+	value, _ := e.LookupPresent(key, fallbacks...)
 	return value
 }
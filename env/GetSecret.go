@@ -0,0 +1,11 @@
+package env
+
+// GetSecret is the same as LookupSecret, without the existence bool.
+func GetSecret(key string, fallbacks ...string) (string, error) {
+	return std.GetSecret(key, fallbacks...)
+}
+
+func (e *Env) GetSecret(key string, fallbacks ...string) (string, error) {
+	value, _, err := e.LookupSecret(key, fallbacks...)
+	return value, err
+}
@@ -0,0 +1,21 @@
+package env_test
+
+import (
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestGetSecret(t *testing.T) {
+	e := env.New(env.MapSource{"DB_PASSWORD_FILE": writeFile(t, t.TempDir(), "secret", "hunter2\n")})
+
+	got, err := e.GetSecret("DB_PASSWORD")
+	if err != nil || got != "hunter2" {
+		t.Errorf("GetSecret() = %q, %v, want %q, nil", got, err, "hunter2")
+	}
+
+	got, err = e.GetSecret("API_TOKEN", "default")
+	if err != nil || got != "default" {
+		t.Errorf("GetSecret() = %q, %v, want %q, nil", got, err, "default")
+	}
+}
@@ -1,21 +1,18 @@
 package env_test
 
 import (
-	"os"
 	"testing"
 
 	"github.com/sampson-golang/utilities/env"
 )
 
 func TestGet(t *testing.T) {
-	os.Setenv("TEST_GETENV_EXISTS", "test_env_value")
-	defer os.Unsetenv("TEST_GETENV_EXISTS")
-	os.Setenv("TEST_GETENV_EXISTS_2", "test_env_value_2")
-	defer os.Unsetenv("TEST_GETENV_EXISTS_2")
-	os.Setenv("TEST_GETENV_EXISTS_3", "test_env_value_3")
-	defer os.Unsetenv("TEST_GETENV_EXISTS_3")
-	os.Setenv("TEST_GETENV_EMPTY", "")
-	defer os.Unsetenv("TEST_GETENV_EMPTY")
+	env.Sandbox(t, map[string]string{
+		"TEST_GETENV_EXISTS":   "test_env_value",
+		"TEST_GETENV_EXISTS_2": "test_env_value_2",
+		"TEST_GETENV_EXISTS_3": "test_env_value_3",
+		"TEST_GETENV_EMPTY":    "",
+	})
 
 	t.Run("env var exists", func(t *testing.T) {
 		got := env.Get("TEST_GETENV_EXISTS")
@@ -84,8 +81,9 @@ func TestGet(t *testing.T) {
 
 // Benchmark tests to ensure the functions perform well
 func BenchmarkGet(b *testing.B) {
-	os.Setenv("BENCHMARK_TEST", "test_value")
-	defer os.Unsetenv("BENCHMARK_TEST")
+	env.Sandbox(b, map[string]string{
+		"BENCHMARK_TEST": "test_value",
+	})
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,17 @@
+package env
+
+import (
+	"reflect"
+)
+
+// Int looks up key like Lookup and parses the value as a decimal integer,
+// or as hexadecimal, octal or binary with an explicit 0x, 0o or 0b prefix.
+func Int(key string, fallbacks ...string) (int, error) {
+	return std.Int(key, fallbacks...)
+}
+
+func (e *Env) Int(key string, fallbacks ...string) (int, error) {
+	var value int
+	err := e.as(reflect.ValueOf(&value).Elem(), key, fallbacks)
+	return value, err
+}
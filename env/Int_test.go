@@ -0,0 +1,50 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestInt(t *testing.T) {
+	os.Setenv("TEST_INT_VALUE", "8080")
+	defer os.Unsetenv("TEST_INT_VALUE")
+	os.Setenv("TEST_INT_HEX", "0x1F")
+	defer os.Unsetenv("TEST_INT_HEX")
+	os.Setenv("TEST_INT_BAD", "8080a")
+	defer os.Unsetenv("TEST_INT_BAD")
+
+	tests := []struct {
+		name      string
+		key       string
+		fallbacks []string
+		want      int
+		wantErr   bool
+	}{
+		{"decimal", "TEST_INT_VALUE", nil, 8080, false},
+		{"base prefixed", "TEST_INT_HEX", nil, 31, false},
+		{"zero padded", "TEST_INT_NOT_EXISTS", []string{"010"}, 10, false},
+		{"zero padded beyond octal", "TEST_INT_NOT_EXISTS", []string{"08"}, 8, false},
+		{"negative zero padded", "TEST_INT_NOT_EXISTS", []string{"-010"}, -10, false},
+		{"octal prefixed", "TEST_INT_NOT_EXISTS", []string{"0o17"}, 15, false},
+		{"binary prefixed", "TEST_INT_NOT_EXISTS", []string{"0b101"}, 5, false},
+		{"literal fallback", "TEST_INT_NOT_EXISTS", []string{"3000"}, 3000, false},
+		{"env fallback", "TEST_INT_NOT_EXISTS", []string{"TEST_INT_VALUE", "3000"}, 8080, false},
+		{"unset", "TEST_INT_NOT_EXISTS", nil, 0, false},
+		{"malformed", "TEST_INT_BAD", []string{"3000"}, 0, true},
+		{"malformed fallback", "TEST_INT_NOT_EXISTS", []string{"three"}, 0, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := env.Int(test.key, test.fallbacks...)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Int() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("Int() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package env
+
+// Isolated returns an Env reading from an in-memory copy of the process environment,
+// with every variable in overrides set and every key in unset removed.
+// The process environment is never modified, so parallel tests can each have their own.
+func Isolated(t TB, overrides map[string]string, unset ...string) *Env {
+	t.Helper()
+
+	values := MapSource{}
+	for _, key := range OS.(Lister).Keys() {
+		values[key], _ = OS.Lookup(key)
+	}
+
+	for key, value := range overrides {
+		values[key] = value
+	}
+	for _, key := range unset {
+		delete(values, key)
+	}
+
+	return New(values)
+}
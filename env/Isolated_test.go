@@ -0,0 +1,48 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestIsolated(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_ISOLATED_INHERITED": "inherited",
+		"TEST_ISOLATED_UNSET":     "inherited",
+	})
+
+	e := env.Isolated(t, map[string]string{
+		"TEST_ISOLATED_OVERRIDE": "override",
+	}, "TEST_ISOLATED_UNSET")
+
+	t.Run("copies the process environment", func(t *testing.T) {
+		if got := e.Get("TEST_ISOLATED_INHERITED"); got != "inherited" {
+			t.Errorf("Get(TEST_ISOLATED_INHERITED) = %v, want %v", got, "inherited")
+		}
+	})
+
+	t.Run("applies overrides and unsets", func(t *testing.T) {
+		if got := e.Get("TEST_ISOLATED_OVERRIDE"); got != "override" {
+			t.Errorf("Get(TEST_ISOLATED_OVERRIDE) = %v, want %v", got, "override")
+		}
+		if e.Exists("TEST_ISOLATED_UNSET") {
+			t.Errorf("Exists(TEST_ISOLATED_UNSET) = true, want false")
+		}
+	})
+
+	t.Run("leaves the process environment alone", func(t *testing.T) {
+		if env.Exists("TEST_ISOLATED_OVERRIDE") {
+			t.Errorf("process Exists(TEST_ISOLATED_OVERRIDE) = true, want false")
+		}
+		if !env.Exists("TEST_ISOLATED_UNSET") {
+			t.Errorf("process Exists(TEST_ISOLATED_UNSET) = false, want true")
+		}
+
+		os.Setenv("TEST_ISOLATED_INHERITED", "changed")
+		if got := e.Get("TEST_ISOLATED_INHERITED"); got != "inherited" {
+			t.Errorf("Get(TEST_ISOLATED_INHERITED) = %v, want %v", got, "inherited")
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package env
+
+import (
+	"reflect"
+)
+
+// List looks up key like Lookup and splits the value into elements with DefaultSplitter,
+// so ALLOWED_ORIGINS=a.com,b.com yields ["a.com" "b.com"]. An unset or empty value yields nil.
+func List(key string, fallbacks ...string) ([]string, error) {
+	return std.List(key, fallbacks...)
+}
+
+func (e *Env) List(key string, fallbacks ...string) ([]string, error) {
+	var list []string
+	err := e.as(reflect.ValueOf(&list).Elem(), key, fallbacks)
+	return list, err
+}
+
+// ListWith is List, splitting the value with splitter instead of DefaultSplitter,
+// so ListWith(Splitter{Separator: ";"}, ...) reads a;b as ["a" "b"].
+func ListWith(splitter Splitter, key string, fallbacks ...string) ([]string, error) {
+	return std.ListWith(splitter, key, fallbacks...)
+}
+
+func (e *Env) ListWith(splitter Splitter, key string, fallbacks ...string) ([]string, error) {
+	var list []string
+	err := e.asWith(reflect.ValueOf(&list).Elem(), key, fallbacks, splitter)
+	return list, err
+}
+
+// ListOf is List, converting each element to T like As.
+func ListOf[T any](key string, fallbacks ...string) ([]T, error) {
+	return As[[]T](key, fallbacks...)
+}
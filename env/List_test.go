@@ -0,0 +1,90 @@
+package env_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestList(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_LIST_ORIGINS": "https://a.com, https://b.com",
+		"TEST_LIST_PORTS":   "80,443,0x1F90",
+		"TEST_LIST_BAD":     "80,http",
+	})
+
+	t.Run("splits the value", func(t *testing.T) {
+		got, err := env.List("TEST_LIST_ORIGINS")
+		want := []string{"https://a.com", "https://b.com"}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("List() = %q, %v, want %q, nil", got, err, want)
+		}
+	})
+
+	t.Run("follows the fallback chain", func(t *testing.T) {
+		got, err := env.List("TEST_LIST_NOT_EXISTS", "x,y")
+		want := []string{"x", "y"}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("List() = %q, %v, want %q, nil", got, err, want)
+		}
+
+		got, err = env.List("TEST_LIST_NOT_EXISTS")
+		if err != nil || got != nil {
+			t.Errorf("List() = %q, %v, want nil, nil", got, err)
+		}
+	})
+
+	t.Run("ListOf converts elements", func(t *testing.T) {
+		got, err := env.ListOf[uint16]("TEST_LIST_PORTS")
+		want := []uint16{80, 443, 8080}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ListOf[uint16]() = %v, %v, want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("ListOf reports malformed elements", func(t *testing.T) {
+		_, err := env.ListOf[int]("TEST_LIST_BAD")
+
+		var parseErr *env.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Key != "TEST_LIST_BAD" {
+			t.Errorf("ListOf[int]() error = %v, want *env.ParseError for TEST_LIST_BAD", err)
+		}
+	})
+}
+
+func TestListWith(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"HOSTS":     "a.com; b.com",
+		"BAD_HOSTS": `"a.com`,
+	})
+	splitter := env.Splitter{Separator: ";"}
+
+	t.Run("splits with the splitter", func(t *testing.T) {
+		got, err := e.ListWith(splitter, "NOT_EXISTS", "HOSTS", "")
+		want := []string{"a.com", "b.com"}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ListWith() = %q, %v, want %q, nil", got, err, want)
+		}
+	})
+
+	t.Run("splits literal fallbacks", func(t *testing.T) {
+		got, err := e.ListWith(splitter, "NOT_EXISTS", "x;y")
+		want := []string{"x", "y"}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("ListWith() = %q, %v, want %q, nil", got, err, want)
+		}
+	})
+
+	t.Run("reports the key", func(t *testing.T) {
+		_, err := e.ListWith(splitter, "NOT_EXISTS", "BAD_HOSTS", "")
+
+		var parseErr *env.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Key != "BAD_HOSTS" {
+			t.Errorf("ListWith() error = %v, want *env.ParseError for BAD_HOSTS", err)
+		}
+	})
+}
@@ -0,0 +1,39 @@
+package env
+
+import (
+	"os"
+)
+
+// LoadFile parses the dotenv file at path and sets each variable in the process environment,
+// so Lookup and friends see file-defined values. Values are expanded like Parse.
+// Variables that are already set are overridden unless override is passed as false,
+// in which case references to them also see the existing value.
+func LoadFile(path string, override ...bool) error {
+	entries, err := readDotenv(path)
+	if err != nil {
+		return err
+	}
+
+	if len(override) > 0 && !override[0] {
+		kept := entries[:0]
+		for _, e := range entries {
+			// Only the exact key counts: a deprecated alias does not claim its replacement.
+			if _, exists := std.source.Lookup(e.key); !exists {
+				kept = append(kept, e)
+			}
+		}
+		entries = kept
+	}
+
+	values, err := expandEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range values {
+		if err := os.Setenv(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package env_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+// writeFile writes content to the named file in dir and returns its path.
+func writeFile(t *testing.T, dir string, name string, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", path, err)
+	}
+	return path
+}
+
+func TestLoadFile(t *testing.T) {
+	path := writeFile(t, t.TempDir(), ".env", "TEST_LOADFILE_NEW=from_file\nTEST_LOADFILE_EXISTING=from_file\n")
+	defer os.Unsetenv("TEST_LOADFILE_NEW")
+	defer os.Unsetenv("TEST_LOADFILE_EXISTING")
+
+	t.Run("does not override when asked not to", func(t *testing.T) {
+		os.Setenv("TEST_LOADFILE_EXISTING", "from_process")
+
+		if err := env.LoadFile(path, false); err != nil {
+			t.Fatalf("LoadFile() error = %v", err)
+		}
+
+		if got := env.Get("TEST_LOADFILE_NEW"); got != "from_file" {
+			t.Errorf("Get() got = %v, want %v", got, "from_file")
+		}
+		if got := env.Get("TEST_LOADFILE_EXISTING"); got != "from_process" {
+			t.Errorf("Get() got = %v, want %v", got, "from_process")
+		}
+	})
+
+	t.Run("overrides by default", func(t *testing.T) {
+		os.Setenv("TEST_LOADFILE_EXISTING", "from_process")
+
+		if err := env.LoadFile(path); err != nil {
+			t.Fatalf("LoadFile() error = %v", err)
+		}
+
+		if got := env.Get("TEST_LOADFILE_EXISTING"); got != "from_file" {
+			t.Errorf("Get() got = %v, want %v", got, "from_file")
+		}
+	})
+
+	t.Run("references see kept values", func(t *testing.T) {
+		os.Setenv("TEST_LOADFILE_EXISTING", "from_process")
+		os.Unsetenv("TEST_LOADFILE_NEW")
+		refPath := writeFile(t, t.TempDir(), ".env", "TEST_LOADFILE_EXISTING=from_file\nTEST_LOADFILE_NEW=${TEST_LOADFILE_EXISTING}\n")
+
+		if err := env.LoadFile(refPath, false); err != nil {
+			t.Fatalf("LoadFile() error = %v", err)
+		}
+		if got := env.Get("TEST_LOADFILE_NEW"); got != "from_process" {
+			t.Errorf("Get() got = %v, want %v", got, "from_process")
+		}
+
+		if err := env.LoadFile(refPath); err != nil {
+			t.Fatalf("LoadFile() error = %v", err)
+		}
+		if got := env.Get("TEST_LOADFILE_NEW"); got != "from_file" {
+			t.Errorf("Get() got = %v, want %v", got, "from_file")
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		err := env.LoadFile(filepath.Join(t.TempDir(), "missing.env"))
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("LoadFile() error = %v, want os.ErrNotExist", err)
+		}
+	})
+
+	t.Run("syntax errors name the file", func(t *testing.T) {
+		badPath := writeFile(t, t.TempDir(), ".env", "TEST_LOADFILE_BAD\n")
+
+		var syntaxErr *env.SyntaxError
+		if err := env.LoadFile(badPath); !errors.As(err, &syntaxErr) || syntaxErr.File != badPath {
+			t.Errorf("LoadFile() error = %v, want *env.SyntaxError for %s", err, badPath)
+		}
+	})
+}
+
+func TestLoadFile_DeprecatedAlias(t *testing.T) {
+	env.Sandbox(t, map[string]string{"TEST_LOADFILE_OLD_ALIAS": "from_process"}, "TEST_LOADFILE_NEW_ALIAS")
+	env.Deprecate("TEST_LOADFILE_OLD_ALIAS", "TEST_LOADFILE_NEW_ALIAS", "")
+	t.Cleanup(env.ResetDeprecations)
+
+	logger := &deprecateTestLogger{}
+	env.SetLogger(logger)
+	t.Cleanup(func() { env.SetLogger(nil) })
+
+	path := writeFile(t, t.TempDir(), ".env", "TEST_LOADFILE_NEW_ALIAS=from_file\n")
+	if err := env.LoadFile(path, false); err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+
+	if got := os.Getenv("TEST_LOADFILE_NEW_ALIAS"); got != "from_file" {
+		t.Errorf("TEST_LOADFILE_NEW_ALIAS = %q, want %q: a deprecated alias must not count as set", got, "from_file")
+	}
+	if len(logger.warnings) != 0 {
+		t.Errorf("warnings = %v, want none", logger.warnings)
+	}
+}
@@ -0,0 +1,21 @@
+package env
+
+import (
+	"os"
+)
+
+// LoadProfile reads profile in dir like ReadProfile, then sets each of its variables
+// in the process environment. Use the returned Profile to see which file each value came from.
+func LoadProfile(dir string, profile string) (*Profile, error) {
+	p, err := ReadProfile(dir, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range p.values {
+		if err := os.Setenv(key, value); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
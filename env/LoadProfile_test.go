@@ -0,0 +1,33 @@
+package env_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestLoadProfile(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_LOADPROFILE_PROCESS": "from_process",
+	})
+
+	dir := t.TempDir()
+	writeFile(t, dir, ".env", "TEST_LOADPROFILE_LEVEL=base\nTEST_LOADPROFILE_PROCESS=from_file\n")
+	writeFile(t, dir, ".env.production", "TEST_LOADPROFILE_LEVEL=production\n")
+
+	profile, err := env.LoadProfile(dir, "production")
+	if err != nil {
+		t.Fatalf("LoadProfile() error = %v", err)
+	}
+
+	if got := env.Get("TEST_LOADPROFILE_LEVEL"); got != "production" {
+		t.Errorf("Get(TEST_LOADPROFILE_LEVEL) = %v, want %v", got, "production")
+	}
+	if got := env.Get("TEST_LOADPROFILE_PROCESS"); got != "from_process" {
+		t.Errorf("Get(TEST_LOADPROFILE_PROCESS) = %v, want %v", got, "from_process")
+	}
+	if got, _ := profile.Origin("TEST_LOADPROFILE_LEVEL"); got != filepath.Join(dir, ".env.production") {
+		t.Errorf("Origin(TEST_LOADPROFILE_LEVEL) = %v, want .env.production", got)
+	}
+}
@@ -0,0 +1,20 @@
+package env
+
+// Logger receives the warnings logged by the package. *slog.Logger satisfies it.
+type Logger interface {
+	Warn(msg string, args ...any)
+}
+
+// SetLogger sets the Logger used for warnings about deprecated variables.
+// A nil logger restores the default, slog.Default().
+func SetLogger(logger Logger) {
+	std.SetLogger(logger)
+}
+
+// SetLogger sets the Logger for this Env and every Env sharing its deprecations.
+func (e *Env) SetLogger(logger Logger) {
+	e.deprecations.mutex.Lock()
+	defer e.deprecations.mutex.Unlock()
+
+	e.deprecations.logger = logger
+}
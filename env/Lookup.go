@@ -1,23 +1,19 @@
 package env
 
 import (
-	"os"
+	"context"
 )
 
 func Lookup(key string, fallbacks ...string) (string, bool) {
-	value, exists := os.LookupEnv(key)
-
-	if exists {
-		return value, true
-	}
-
-	if len(fallbacks) == 0 {
-		return "", false
-	}
+	return std.Lookup(key, fallbacks...)
+}
 
-	if len(fallbacks) > 1 {
-		return Lookup(fallbacks[0], fallbacks[1:]...)
-	}
+// LookupCtx is Lookup, consulting any Source attached to ctx with WithSource first.
+func LookupCtx(ctx context.Context, key string, fallbacks ...string) (string, bool) {
+	return FromContext(ctx).Lookup(key, fallbacks...)
+}
 
-	return fallbacks[0], false
+func (e *Env) Lookup(key string, fallbacks ...string) (string, bool) {
+	result := e.resolve(key, fallbacks, false)
+	return result.Value, result.Found
 }
@@ -0,0 +1,111 @@
+package env
+
+// Result describes how a lookup was resolved.
+type Result struct {
+	// Value is the resolved value, as Lookup would return it.
+	Value string `json:"value"`
+	// Key is the variable that satisfied the lookup, empty if none did.
+	Key string `json:"key,omitempty"`
+	// Found is true when a variable satisfied the lookup.
+	Found bool `json:"found"`
+	// Default is true when Value is the literal fallback.
+	Default bool `json:"default"`
+	// Empty is true when the matching variable was present but empty.
+	Empty bool `json:"empty"`
+	// Deprecated is true when Key is a deprecated alias.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// Keys lists every variable consulted, in order, including deprecated aliases.
+	Keys []string `json:"keys"`
+	// Err is a *DeprecatedError when a deprecated alias was refused in strict mode.
+	Err error `json:"-"`
+}
+
+// Source returns a short description of where the value came from.
+func (r Result) Source() string {
+	switch {
+	case r.Found:
+		return r.Key
+	case r.Default:
+		return "default"
+	default:
+		return "unset"
+	}
+}
+
+// parseKey names the variable a malformed value came from,
+// falling back to the primary key when the value was a default.
+func (r Result) parseKey() string {
+	if r.Found {
+		return r.Key
+	}
+	return r.Keys[0]
+}
+
+// LookupDetailed resolves key and fallbacks exactly like Lookup,
+// and reports which key, if any, satisfied the lookup.
+func LookupDetailed(key string, fallbacks ...string) Result {
+	return std.LookupDetailed(key, fallbacks...)
+}
+
+func (e *Env) LookupDetailed(key string, fallbacks ...string) Result {
+	return e.resolve(key, fallbacks, false)
+}
+
+// resolve walks the fallback chain shared by Lookup and LookupPresent:
+// every fallback but the last is another key, and the last is a literal value.
+// Each key is followed by its deprecated aliases. When present is true, empty variables are skipped.
+func (e *Env) resolve(key string, fallbacks []string, present bool) Result {
+	keys := []string{key}
+	if len(fallbacks) > 1 {
+		keys = append(keys, fallbacks[:len(fallbacks)-1]...)
+	}
+
+	result := Result{Keys: make([]string, 0, len(keys))}
+	for _, k := range keys {
+		name := e.prefix + k
+		if e.match(&result, name, present) || e.matchAlias(&result, name, present) {
+			break
+		}
+	}
+
+	if !result.Found && len(fallbacks) > 0 {
+		result.Value = fallbacks[len(fallbacks)-1]
+		result.Default = true
+	}
+
+	e.trail.record(result)
+	return result
+}
+
+// match records that name was consulted, and fills in result if it satisfies the lookup.
+func (e *Env) match(result *Result, name string, present bool) bool {
+	result.Keys = append(result.Keys, name)
+
+	value, exists := e.source.Lookup(name)
+	if !exists || (present && value == "") {
+		return false
+	}
+
+	deprecated, err := e.deprecations.check(name)
+	if err != nil {
+		result.Err = err
+		return false
+	}
+
+	result.Value = value
+	result.Key = name
+	result.Found = true
+	result.Empty = value == ""
+	result.Deprecated = deprecated
+	return true
+}
+
+// matchAlias tries the deprecated aliases of name.
+func (e *Env) matchAlias(result *Result, name string, present bool) bool {
+	for _, dep := range e.deprecations.aliases(name) {
+		if e.match(result, dep.key, present) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package env_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestLookupDetailed(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"EXISTS":   "value",
+		"EXISTS_2": "value_2",
+		"EMPTY":    "",
+	})
+
+	tests := []struct {
+		name      string
+		key       string
+		fallbacks []string
+		want      env.Result
+	}{
+		{
+			name: "exists",
+			key:  "EXISTS",
+			want: env.Result{Value: "value", Key: "EXISTS", Found: true, Keys: []string{"EXISTS"}},
+		},
+		{
+			name: "does not exist",
+			key:  "NOT_EXISTS",
+			want: env.Result{Keys: []string{"NOT_EXISTS"}},
+		},
+		{
+			name:      "literal fallback",
+			key:       "NOT_EXISTS",
+			fallbacks: []string{"fallback"},
+			want:      env.Result{Value: "fallback", Default: true, Keys: []string{"NOT_EXISTS"}},
+		},
+		{
+			name:      "env fallback",
+			key:       "NOT_EXISTS",
+			fallbacks: []string{"EXISTS_2", "fallback"},
+			want:      env.Result{Value: "value_2", Key: "EXISTS_2", Found: true, Keys: []string{"NOT_EXISTS", "EXISTS_2"}},
+		},
+		{
+			name:      "present but empty",
+			key:       "EMPTY",
+			fallbacks: []string{"fallback"},
+			want:      env.Result{Key: "EMPTY", Found: true, Empty: true, Keys: []string{"EMPTY"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := e.LookupDetailed(test.key, test.fallbacks...); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("LookupDetailed() = %+v, want %+v", got, test.want)
+			}
+		})
+	}
+
+	t.Run("reports prefixed keys", func(t *testing.T) {
+		got := e.WithPrefix("EXISTS_").LookupDetailed("2")
+		if got.Key != "EXISTS_2" || got.Value != "value_2" {
+			t.Errorf("LookupDetailed() = %+v, want key %v", got, "EXISTS_2")
+		}
+	})
+
+	t.Run("Source", func(t *testing.T) {
+		for _, test := range []struct {
+			result env.Result
+			want   string
+		}{
+			{env.Result{Key: "EXISTS", Found: true}, "EXISTS"},
+			{env.Result{Default: true}, "default"},
+			{env.Result{}, "unset"},
+		} {
+			if got := test.result.Source(); got != test.want {
+				t.Errorf("Source() = %v, want %v", got, test.want)
+			}
+		}
+	})
+}
@@ -1,19 +1,19 @@
 package env
 
-func LookupPresent(key string, fallbacks ...string) (string, bool) {
-	value, exists := Lookup(key)
-
-	if exists && value != "" {
-		return value, true
-	}
+import (
+	"context"
+)
 
-	if len(fallbacks) == 0 {
-		return "", false
-	}
+func LookupPresent(key string, fallbacks ...string) (string, bool) {
+	return std.LookupPresent(key, fallbacks...)
+}
 
-	if len(fallbacks) > 1 {
-		return LookupPresent(fallbacks[0], fallbacks[1:]...)
-	}
+// LookupPresentCtx is LookupPresent, consulting any Source attached to ctx with WithSource first.
+func LookupPresentCtx(ctx context.Context, key string, fallbacks ...string) (string, bool) {
+	return FromContext(ctx).LookupPresent(key, fallbacks...)
+}
 
-	return fallbacks[0], false
+func (e *Env) LookupPresent(key string, fallbacks ...string) (string, bool) {
+	result := e.resolve(key, fallbacks, true)
+	return result.Value, result.Found
 }
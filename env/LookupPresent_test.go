@@ -1,21 +1,18 @@
 package env_test
 
 import (
-	"os"
 	"testing"
 
 	"github.com/sampson-golang/utilities/env"
 )
 
 func TestLookupPresent(t *testing.T) {
-	os.Setenv("TEST_GETPRESENT_EXISTS", "test_env_value")
-	defer os.Unsetenv("TEST_GETPRESENT_EXISTS")
-	os.Setenv("TEST_GETPRESENT_EXISTS_2", "test_env_value_2")
-	defer os.Unsetenv("TEST_GETPRESENT_EXISTS_2")
-	os.Setenv("TEST_GETPRESENT_EXISTS_3", "test_env_value_3")
-	defer os.Unsetenv("TEST_GETPRESENT_EXISTS_3")
-	os.Setenv("TEST_GETPRESENT_EMPTY", "")
-	defer os.Unsetenv("TEST_GETPRESENT_EMPTY")
+	env.Sandbox(t, map[string]string{
+		"TEST_GETPRESENT_EXISTS":   "test_env_value",
+		"TEST_GETPRESENT_EXISTS_2": "test_env_value_2",
+		"TEST_GETPRESENT_EXISTS_3": "test_env_value_3",
+		"TEST_GETPRESENT_EMPTY":    "",
+	})
 
 	t.Run("env var exists", func(t *testing.T) {
 		got, gotExists := env.LookupPresent("TEST_GETPRESENT_EXISTS")
@@ -100,8 +97,9 @@ func TestLookupPresent(t *testing.T) {
 }
 
 func BenchmarkLookupPresent(b *testing.B) {
-	os.Setenv("BENCHMARK_TEST", "test_value")
-	defer os.Unsetenv("BENCHMARK_TEST")
+	env.Sandbox(b, map[string]string{
+		"BENCHMARK_TEST": "test_value",
+	})
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,79 @@
+package env
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// MaxSecretSize is the largest secret file, in bytes, that LookupSecret will read.
+var MaxSecretSize int64 = 64 << 10
+
+// ErrSecretTooLarge is reported when a secret file exceeds MaxSecretSize.
+var ErrSecretTooLarge = errors.New("secret file exceeds size limit")
+
+// LookupSecret behaves like Lookup, but also supports the Docker/Kubernetes convention
+// of mounting secrets as files: when KEY is unset but KEY_FILE is set, the file it names
+// is read and its contents, without a trailing newline, are used as the value.
+// Failing to read the file is reported as a *SecretError rather than as an unset variable.
+// Keys read with LookupSecret are always redacted from Config, where a value read from
+// a file is reported with KEY_FILE as its source.
+func LookupSecret(key string, fallbacks ...string) (string, bool, error) {
+	return std.LookupSecret(key, fallbacks...)
+}
+
+func (e *Env) LookupSecret(key string, fallbacks ...string) (string, bool, error) {
+	keys := []string{key}
+	if len(fallbacks) > 1 {
+		keys = append(keys, fallbacks[:len(fallbacks)-1]...)
+	}
+
+	result := Result{Keys: make([]string, 0, len(keys))}
+	for _, k := range keys {
+		name := e.prefix + k
+		e.trail.markSecret(name)
+
+		if e.match(&result, name, false) || e.matchAlias(&result, name, false) {
+			break
+		}
+
+		if e.match(&result, name+"_FILE", false) {
+			value, err := readSecret(result.Value)
+			if err != nil {
+				e.trail.record(Result{Keys: result.Keys})
+				return "", false, &SecretError{Key: name + "_FILE", Path: result.Value, Err: err}
+			}
+			result.Value = value
+			break
+		}
+	}
+
+	if !result.Found && len(fallbacks) > 0 {
+		result.Value = fallbacks[len(fallbacks)-1]
+		result.Default = true
+	}
+
+	e.trail.record(result)
+	return result.Value, result.Found, nil
+}
+
+func readSecret(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, MaxSecretSize+1))
+	if err != nil {
+		return "", err
+	}
+
+	if int64(len(data)) > MaxSecretSize {
+		return "", ErrSecretTooLarge
+	}
+
+	value := strings.TrimSuffix(string(data), "\n")
+	return strings.TrimSuffix(value, "\r"), nil
+}
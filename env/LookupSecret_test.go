@@ -0,0 +1,94 @@
+package env_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestLookupSecret(t *testing.T) {
+	secretPath := writeFile(t, t.TempDir(), "secret", "s3cr3t\n")
+	crlfPath := writeFile(t, t.TempDir(), "secret", "windows\r\n")
+	multilinePath := writeFile(t, t.TempDir(), "secret", "line 1\nline 2\n\n")
+
+	os.Setenv("TEST_SECRET_DIRECT", "direct")
+	defer os.Unsetenv("TEST_SECRET_DIRECT")
+	os.Setenv("TEST_SECRET_DIRECT_FILE", secretPath)
+	defer os.Unsetenv("TEST_SECRET_DIRECT_FILE")
+	os.Setenv("TEST_SECRET_FROM_FILE_FILE", secretPath)
+	defer os.Unsetenv("TEST_SECRET_FROM_FILE_FILE")
+	os.Setenv("TEST_SECRET_CRLF_FILE", crlfPath)
+	defer os.Unsetenv("TEST_SECRET_CRLF_FILE")
+	os.Setenv("TEST_SECRET_MULTILINE_FILE", multilinePath)
+	defer os.Unsetenv("TEST_SECRET_MULTILINE_FILE")
+
+	tests := []struct {
+		name       string
+		key        string
+		fallbacks  []string
+		want       string
+		wantExists bool
+	}{
+		{"variable wins over file", "TEST_SECRET_DIRECT", nil, "direct", true},
+		{"reads file", "TEST_SECRET_FROM_FILE", nil, "s3cr3t", true},
+		{"trims carriage return", "TEST_SECRET_CRLF", nil, "windows", true},
+		{"trims only one newline", "TEST_SECRET_MULTILINE", nil, "line 1\nline 2\n", true},
+		{"not set", "TEST_SECRET_NOT_EXISTS", nil, "", false},
+		{"literal fallback", "TEST_SECRET_NOT_EXISTS", []string{"fallback"}, "fallback", false},
+		{"file fallback", "TEST_SECRET_NOT_EXISTS", []string{"TEST_SECRET_FROM_FILE", "fallback"}, "s3cr3t", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, exists, err := env.LookupSecret(test.key, test.fallbacks...)
+			if err != nil {
+				t.Fatalf("LookupSecret() error = %v", err)
+			}
+			if got != test.want || exists != test.wantExists {
+				t.Errorf("LookupSecret() = %q, %v, want %q, %v", got, exists, test.want, test.wantExists)
+			}
+		})
+	}
+
+	t.Run("missing file is an error", func(t *testing.T) {
+		os.Setenv("TEST_SECRET_MISSING_FILE", filepath.Join(t.TempDir(), "missing"))
+		defer os.Unsetenv("TEST_SECRET_MISSING_FILE")
+
+		_, exists, err := env.LookupSecret("TEST_SECRET_MISSING", "fallback")
+
+		var secretErr *env.SecretError
+		if !errors.As(err, &secretErr) || secretErr.Key != "TEST_SECRET_MISSING_FILE" {
+			t.Fatalf("LookupSecret() error = %v, want *env.SecretError", err)
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("LookupSecret() error = %v, want os.ErrNotExist", err)
+		}
+		if exists {
+			t.Errorf("LookupSecret() exists = true, want false")
+		}
+	})
+
+	t.Run("enforces the size limit", func(t *testing.T) {
+		defer func(size int64) { env.MaxSecretSize = size }(env.MaxSecretSize)
+		env.MaxSecretSize = 8
+
+		largePath := writeFile(t, t.TempDir(), "secret", strings.Repeat("x", 9))
+		os.Setenv("TEST_SECRET_LARGE_FILE", largePath)
+		defer os.Unsetenv("TEST_SECRET_LARGE_FILE")
+
+		if _, _, err := env.LookupSecret("TEST_SECRET_LARGE"); !errors.Is(err, env.ErrSecretTooLarge) {
+			t.Errorf("LookupSecret() error = %v, want env.ErrSecretTooLarge", err)
+		}
+
+		exactPath := writeFile(t, t.TempDir(), "secret", strings.Repeat("x", 8))
+		os.Setenv("TEST_SECRET_LARGE_FILE", exactPath)
+
+		if got, _, err := env.LookupSecret("TEST_SECRET_LARGE"); err != nil || got != "xxxxxxxx" {
+			t.Errorf("LookupSecret() = %q, %v, want %q, nil", got, err, "xxxxxxxx")
+		}
+	})
+}
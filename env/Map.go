@@ -0,0 +1,33 @@
+package env
+
+import (
+	"reflect"
+)
+
+// Map looks up key like Lookup and splits the value into key/value pairs with DefaultSplitter,
+// so LABELS=team=core,tier=1 yields map[team:core tier:1]. An unset or empty value yields nil.
+func Map(key string, fallbacks ...string) (map[string]string, error) {
+	return std.Map(key, fallbacks...)
+}
+
+func (e *Env) Map(key string, fallbacks ...string) (map[string]string, error) {
+	var pairs map[string]string
+	err := e.as(reflect.ValueOf(&pairs).Elem(), key, fallbacks)
+	return pairs, err
+}
+
+// MapWith is Map, splitting the value with splitter instead of DefaultSplitter.
+func MapWith(splitter Splitter, key string, fallbacks ...string) (map[string]string, error) {
+	return std.MapWith(splitter, key, fallbacks...)
+}
+
+func (e *Env) MapWith(splitter Splitter, key string, fallbacks ...string) (map[string]string, error) {
+	var pairs map[string]string
+	err := e.asWith(reflect.ValueOf(&pairs).Elem(), key, fallbacks, splitter)
+	return pairs, err
+}
+
+// MapOf is Map, converting each value to V like As.
+func MapOf[V any](key string, fallbacks ...string) (map[string]V, error) {
+	return As[map[string]V](key, fallbacks...)
+}
@@ -0,0 +1,17 @@
+package env
+
+// MapSource is an in-memory Source. It is not safe for concurrent writes.
+type MapSource map[string]string
+
+func (m MapSource) Lookup(key string) (string, bool) {
+	value, exists := m[key]
+	return value, exists
+}
+
+func (m MapSource) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
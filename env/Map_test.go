@@ -0,0 +1,68 @@
+package env_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestMap(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_MAP_LABELS":   "team=core, tier=1",
+		"TEST_MAP_TIMEOUTS": "read=5s,write=1m",
+		"TEST_MAP_BAD":      "team",
+	})
+
+	t.Run("splits the value", func(t *testing.T) {
+		got, err := env.Map("TEST_MAP_LABELS")
+		want := map[string]string{"team": "core", "tier": "1"}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Map() = %v, %v, want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("follows the fallback chain", func(t *testing.T) {
+		got, err := env.Map("TEST_MAP_NOT_EXISTS", "TEST_MAP_LABELS", "")
+		if err != nil || got["team"] != "core" {
+			t.Errorf("Map() = %v, %v, want team=core", got, err)
+		}
+	})
+
+	t.Run("MapOf converts values", func(t *testing.T) {
+		got, err := env.MapOf[time.Duration]("TEST_MAP_TIMEOUTS")
+		want := map[string]time.Duration{"read": 5 * time.Second, "write": time.Minute}
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("MapOf[time.Duration]() = %v, %v, want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("reports malformed pairs", func(t *testing.T) {
+		_, err := env.Map("TEST_MAP_BAD")
+
+		var parseErr *env.ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Map() error = %v, want *env.ParseError", err)
+		}
+	})
+}
+
+func TestMapWith(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{"LABELS": "team:core;tier:1"})
+	splitter := env.Splitter{Separator: ";", Pair: ":"}
+
+	got, err := e.MapWith(splitter, "NOT_EXISTS", "LABELS", "")
+	want := map[string]string{"team": "core", "tier": "1"}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("MapWith() = %v, %v, want %v, nil", got, err, want)
+	}
+
+	got, err = e.MapWith(splitter, "NOT_EXISTS", "a:1")
+	if err != nil || got["a"] != "1" {
+		t.Errorf("MapWith() = %v, %v, want a:1", got, err)
+	}
+}
@@ -0,0 +1,22 @@
+package env
+
+import (
+	"io"
+)
+
+// Parse reads dotenv formatted content and returns the variables it defines.
+// Later assignments to the same key win, and references to other variables are
+// expanded like ExpandMap, except within single quotes or backticks.
+func Parse(r io.Reader) (map[string]string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := parseDotenv(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return expandEntries(entries)
+}
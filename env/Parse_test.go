@@ -0,0 +1,143 @@
+package env_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestParse(t *testing.T) {
+	input := strings.Join([]string{
+		"# leading comment",
+		"PLAIN=value",
+		"SPACED = spaced value  ",
+		"export EXPORTED=exported",
+		"EMPTY=",
+		"INLINE=value # comment",
+		"HASH=value#not-a-comment",
+		"SINGLE='single $HOME \\n'",
+		`DOUBLE="double \"quoted\"\n\ttabbed \$HOME"`,
+		"BACKTICK=`it's \"raw\"`",
+		"QUOTED_COMMENT=\"quoted\" # comment",
+		"MULTILINE=\"first",
+		"second\"",
+		"SINGLE_MULTILINE='first",
+		"second'",
+		"",
+		"  # indented comment",
+		"WINDOWS=crlf\r",
+		"DOTTED.KEY-NAME=dotted",
+		"PLAIN=overridden",
+	}, "\n")
+
+	got, err := env.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := map[string]string{
+		"PLAIN":            "overridden",
+		"SPACED":           "spaced value",
+		"EXPORTED":         "exported",
+		"EMPTY":            "",
+		"INLINE":           "value",
+		"HASH":             "value#not-a-comment",
+		"SINGLE":           "single $HOME \\n",
+		"DOUBLE":           "double \"quoted\"\n\ttabbed $HOME",
+		"BACKTICK":         "it's \"raw\"",
+		"QUOTED_COMMENT":   "quoted",
+		"MULTILINE":        "first\nsecond",
+		"SINGLE_MULTILINE": "first\nsecond",
+		"WINDOWS":          "crlf",
+		"DOTTED.KEY-NAME":  "dotted",
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("Parse() returned %d keys, want %d: %v", len(got), len(want), got)
+	}
+
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("Parse()[%s] = %q, want %q", key, got[key], value)
+		}
+	}
+}
+
+func TestParse_SyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		line  int
+	}{
+		{"missing equals", "VALID=1\nINVALID", 2},
+		{"invalid key", "=value", 1},
+		{"unterminated double quote", "A=1\nB=\"open\n\nC=3", 2},
+		{"unterminated single quote", "A='open", 1},
+		{"text after quoted value", "A=\"quoted\" trailing", 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := env.Parse(strings.NewReader(test.input))
+
+			var syntaxErr *env.SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse() error = %v, want *env.SyntaxError", err)
+			}
+			if syntaxErr.Line != test.line {
+				t.Errorf("SyntaxError.Line = %d, want %d", syntaxErr.Line, test.line)
+			}
+		})
+	}
+}
+
+func TestParse_Expansion(t *testing.T) {
+	input := strings.Join([]string{
+		"DATABASE_URL=postgres://${DB_HOST}:${DB_PORT}",
+		"DB_HOST=localhost",
+		`DB_PORT="${PORT:-5432}"`,
+		"LITERAL='${DB_HOST}'",
+		"BACKTICK=`${DB_HOST}`",
+		"FROM_LITERAL=${LITERAL}",
+		`ESCAPED="\${DB_HOST}"`,
+		`UNQUOTED_ESCAPED=\${DB_HOST}`,
+		`BACKSLASH="C:\\$DB_HOST"`,
+		`BACKSLASH_ESCAPED="C:\\\$DB_HOST"`,
+		`BACKSLASH_DEFAULT="${UNSET_VAR:-\\$DB_HOST}"`,
+	}, "\n")
+
+	got, err := env.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := map[string]string{
+		"DATABASE_URL":      "postgres://localhost:5432",
+		"DB_PORT":           "5432",
+		"LITERAL":           "${DB_HOST}",
+		"BACKTICK":          "${DB_HOST}",
+		"FROM_LITERAL":      "${DB_HOST}",
+		"ESCAPED":           "${DB_HOST}",
+		"UNQUOTED_ESCAPED":  "${DB_HOST}",
+		"BACKSLASH":         `C:\localhost`,
+		"BACKSLASH_ESCAPED": `C:\$DB_HOST`,
+		"BACKSLASH_DEFAULT": `\localhost`,
+	}
+
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("Parse()[%s] = %q, want %q", key, got[key], value)
+		}
+	}
+
+	t.Run("reports expansion errors", func(t *testing.T) {
+		_, err := env.Parse(strings.NewReader("A=${B}\nB=${A}"))
+
+		var expandErr *env.ExpandError
+		if !errors.As(err, &expandErr) {
+			t.Errorf("Parse() error = %v, want *env.ExpandError", err)
+		}
+	})
+}
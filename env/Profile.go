@@ -0,0 +1,99 @@
+package env
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+)
+
+// ProfileKey names the variable holding the active profile when none is given.
+var ProfileKey = "APP_ENV"
+
+// Profile holds the merged values of a profile's layered dotenv files.
+// It is a Source, so it can be layered under the process environment with Chain.
+type Profile struct {
+	// Name is the profile loaded, possibly empty.
+	Name string
+	// Files lists the files that existed and were read, lowest precedence first.
+	Files []string
+
+	values  map[string]string
+	origins map[string]string
+}
+
+// ProfileFiles returns the files read for profile, lowest precedence first:
+// .env, .env.local, .env.<profile> and .env.<profile>.local. Like dotenv-flow and Vite,
+// .env.local is skipped for the "test" profile so tests are reproducible.
+func ProfileFiles(profile string) []string {
+	files := []string{".env"}
+	if profile != "test" {
+		files = append(files, ".env.local")
+	}
+	if profile != "" {
+		files = append(files, ".env."+profile, ".env."+profile+".local")
+	}
+	return files
+}
+
+// ReadProfile reads the ProfileFiles of profile in dir without modifying the process environment.
+// An empty profile is read from ProfileKey. Missing files are skipped, later files override
+// earlier ones, and values are expanded once all files are merged. Variables already set
+// in the process environment always win: they are left out of the profile, and references
+// to them see the process value.
+func ReadProfile(dir string, profile string) (*Profile, error) {
+	if profile == "" {
+		profile = Get(ProfileKey)
+	}
+
+	p := &Profile{Name: profile, origins: map[string]string{}}
+
+	var merged []entry
+	for _, name := range ProfileFiles(profile) {
+		path := filepath.Join(dir, name)
+
+		entries, err := readDotenv(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		p.Files = append(p.Files, path)
+		for _, e := range entries {
+			// Only the exact key counts: a deprecated alias does not claim its replacement.
+			if _, exists := std.source.Lookup(e.key); exists {
+				continue
+			}
+			merged = append(merged, e)
+			p.origins[e.key] = path
+		}
+	}
+
+	values, err := expandEntries(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	p.values = values
+	return p, nil
+}
+
+func (p *Profile) Lookup(key string) (string, bool) {
+	value, exists := p.values[key]
+	return value, exists
+}
+
+func (p *Profile) Keys() []string {
+	keys := make([]string, 0, len(p.values))
+	for key := range p.values {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Origin returns the file that defined key, or false if no profile file did.
+func (p *Profile) Origin(key string) (string, bool) {
+	origin, exists := p.origins[key]
+	return origin, exists
+}
@@ -0,0 +1,150 @@
+package env_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestProfileFiles(t *testing.T) {
+	tests := []struct {
+		profile string
+		want    []string
+	}{
+		{"", []string{".env", ".env.local"}},
+		{"production", []string{".env", ".env.local", ".env.production", ".env.production.local"}},
+		{"test", []string{".env", ".env.test", ".env.test.local"}},
+	}
+
+	for _, test := range tests {
+		if got := env.ProfileFiles(test.profile); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ProfileFiles(%q) = %v, want %v", test.profile, got, test.want)
+		}
+	}
+}
+
+func TestReadProfile(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_PROFILE_PROCESS": "from_process",
+	}, "APP_ENV")
+
+	dir := t.TempDir()
+	writeFile(t, dir, ".env", "TEST_PROFILE_BASE=base\nTEST_PROFILE_LEVEL=base\n"+
+		"TEST_PROFILE_URL=https://${TEST_PROFILE_HOST}\nTEST_PROFILE_HOST=localhost\n"+
+		"TEST_PROFILE_PROCESS=from_file\nTEST_PROFILE_SEES_PROCESS=${TEST_PROFILE_PROCESS}\n")
+	writeFile(t, dir, ".env.local", "TEST_PROFILE_LEVEL=local\n")
+	writeFile(t, dir, ".env.production", "TEST_PROFILE_LEVEL=production\nTEST_PROFILE_HOST=example.com\n")
+	writeFile(t, dir, ".env.production.local", "TEST_PROFILE_LEVEL=production.local\n")
+	writeFile(t, dir, ".env.staging", "TEST_PROFILE_LEVEL=staging\n")
+	writeFile(t, dir, ".env.test", "TEST_PROFILE_LEVEL=test\n")
+
+	profile, err := env.ReadProfile(dir, "production")
+	if err != nil {
+		t.Fatalf("ReadProfile() error = %v", err)
+	}
+
+	t.Run("later files win", func(t *testing.T) {
+		if got, _ := profile.Lookup("TEST_PROFILE_LEVEL"); got != "production.local" {
+			t.Errorf("TEST_PROFILE_LEVEL = %v, want %v", got, "production.local")
+		}
+		if got, _ := profile.Lookup("TEST_PROFILE_BASE"); got != "base" {
+			t.Errorf("TEST_PROFILE_BASE = %v, want %v", got, "base")
+		}
+	})
+
+	t.Run("expands merged values", func(t *testing.T) {
+		if got, _ := profile.Lookup("TEST_PROFILE_URL"); got != "https://example.com" {
+			t.Errorf("TEST_PROFILE_URL = %v, want %v", got, "https://example.com")
+		}
+	})
+
+	t.Run("process variables win", func(t *testing.T) {
+		if _, exists := profile.Lookup("TEST_PROFILE_PROCESS"); exists {
+			t.Errorf("TEST_PROFILE_PROCESS is in the profile, want it left to the process")
+		}
+		if got, _ := profile.Lookup("TEST_PROFILE_SEES_PROCESS"); got != "from_process" {
+			t.Errorf("TEST_PROFILE_SEES_PROCESS = %v, want %v", got, "from_process")
+		}
+	})
+
+	t.Run("reports origins", func(t *testing.T) {
+		want := []string{
+			filepath.Join(dir, ".env"),
+			filepath.Join(dir, ".env.local"),
+			filepath.Join(dir, ".env.production"),
+			filepath.Join(dir, ".env.production.local"),
+		}
+		if !reflect.DeepEqual(profile.Files, want) {
+			t.Errorf("Files = %v, want %v", profile.Files, want)
+		}
+
+		if got, _ := profile.Origin("TEST_PROFILE_LEVEL"); got != want[3] {
+			t.Errorf("Origin(TEST_PROFILE_LEVEL) = %v, want %v", got, want[3])
+		}
+		if got, _ := profile.Origin("TEST_PROFILE_HOST"); got != want[2] {
+			t.Errorf("Origin(TEST_PROFILE_HOST) = %v, want %v", got, want[2])
+		}
+		if _, exists := profile.Origin("TEST_PROFILE_PROCESS"); exists {
+			t.Errorf("Origin(TEST_PROFILE_PROCESS) exists, want process variables to have no file")
+		}
+	})
+
+	t.Run("reads the profile from APP_ENV", func(t *testing.T) {
+		env.Sandbox(t, map[string]string{"APP_ENV": "staging"})
+
+		profile, err := env.ReadProfile(dir, "")
+		if err != nil {
+			t.Fatalf("ReadProfile() error = %v", err)
+		}
+		if got, _ := profile.Lookup("TEST_PROFILE_LEVEL"); profile.Name != "staging" || got != "staging" {
+			t.Errorf("ReadProfile() = %v with TEST_PROFILE_LEVEL = %v, want staging", profile.Name, got)
+		}
+	})
+
+	t.Run("skips .env.local for test", func(t *testing.T) {
+		profile, err := env.ReadProfile(dir, "test")
+		if err != nil {
+			t.Fatalf("ReadProfile() error = %v", err)
+		}
+		if len(profile.Files) != 2 {
+			t.Errorf("Files = %v, want .env and .env.test", profile.Files)
+		}
+	})
+
+	t.Run("layers under the process environment", func(t *testing.T) {
+		e := env.New(env.Chain(env.OS, profile))
+		if got := e.Get("TEST_PROFILE_PROCESS"); got != "from_process" {
+			t.Errorf("Get(TEST_PROFILE_PROCESS) = %v, want %v", got, "from_process")
+		}
+		if got := e.Get("TEST_PROFILE_LEVEL"); got != "production.local" {
+			t.Errorf("Get(TEST_PROFILE_LEVEL) = %v, want %v", got, "production.local")
+		}
+	})
+
+	t.Run("reports syntax errors with the file", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, dir, ".env.broken", "NOT VALID\n")
+		if _, err := env.ReadProfile(dir, "broken"); err == nil {
+			t.Errorf("ReadProfile() error = nil, want error")
+		}
+	})
+}
+
+func TestReadProfile_DeprecatedAlias(t *testing.T) {
+	env.Sandbox(t, map[string]string{"TEST_PROFILE_OLD_ALIAS": "from_process"}, "TEST_PROFILE_NEW_ALIAS")
+	env.Deprecate("TEST_PROFILE_OLD_ALIAS", "TEST_PROFILE_NEW_ALIAS", "")
+	t.Cleanup(env.ResetDeprecations)
+
+	dir := t.TempDir()
+	writeFile(t, dir, ".env", "TEST_PROFILE_NEW_ALIAS=from_file\n")
+
+	profile, err := env.ReadProfile(dir, "development")
+	if err != nil {
+		t.Fatalf("ReadProfile() error = %v", err)
+	}
+	if got, _ := profile.Lookup("TEST_PROFILE_NEW_ALIAS"); got != "from_file" {
+		t.Errorf("TEST_PROFILE_NEW_ALIAS = %q, want %q: a deprecated alias must not count as set", got, "from_file")
+	}
+}
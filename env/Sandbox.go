@@ -0,0 +1,37 @@
+package env
+
+import (
+	"os"
+	"strings"
+)
+
+// TB is the subset of testing.TB used by Sandbox and Isolated.
+type TB interface {
+	Helper()
+	Cleanup(func())
+}
+
+// Sandbox snapshots the process environment, sets every variable in overrides,
+// unsets every key in unset, and restores the snapshot when the test finishes,
+// undoing any other change the test made to the environment as well.
+// Because the process environment is global, tests using Sandbox must not run in parallel;
+// use Isolated for parallel tests.
+func Sandbox(t TB, overrides map[string]string, unset ...string) {
+	t.Helper()
+
+	snapshot := os.Environ()
+	t.Cleanup(func() {
+		os.Clearenv()
+		for _, variable := range snapshot {
+			key, value, _ := strings.Cut(variable, "=")
+			os.Setenv(key, value)
+		}
+	})
+
+	for key, value := range overrides {
+		os.Setenv(key, value)
+	}
+	for _, key := range unset {
+		os.Unsetenv(key)
+	}
+}
@@ -0,0 +1,48 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestSandbox(t *testing.T) {
+	env.Sandbox(t, map[string]string{
+		"TEST_SANDBOX_KEEP":  "original",
+		"TEST_SANDBOX_UNSET": "original",
+	})
+
+	t.Run("applies overrides and unsets", func(t *testing.T) {
+		env.Sandbox(t, map[string]string{
+			"TEST_SANDBOX_KEEP": "override",
+			"TEST_SANDBOX_NEW":  "new",
+		}, "TEST_SANDBOX_UNSET")
+
+		if got := env.Get("TEST_SANDBOX_KEEP"); got != "override" {
+			t.Errorf("Get(TEST_SANDBOX_KEEP) = %v, want %v", got, "override")
+		}
+		if got := env.Get("TEST_SANDBOX_NEW"); got != "new" {
+			t.Errorf("Get(TEST_SANDBOX_NEW) = %v, want %v", got, "new")
+		}
+		if env.Exists("TEST_SANDBOX_UNSET") {
+			t.Errorf("Exists(TEST_SANDBOX_UNSET) = true, want false")
+		}
+
+		os.Setenv("TEST_SANDBOX_LEAKED", "leaked")
+	})
+
+	t.Run("restores the environment", func(t *testing.T) {
+		if got := env.Get("TEST_SANDBOX_KEEP"); got != "original" {
+			t.Errorf("Get(TEST_SANDBOX_KEEP) = %v, want %v", got, "original")
+		}
+		if got := env.Get("TEST_SANDBOX_UNSET"); got != "original" {
+			t.Errorf("Get(TEST_SANDBOX_UNSET) = %v, want %v", got, "original")
+		}
+		for _, key := range []string{"TEST_SANDBOX_NEW", "TEST_SANDBOX_LEAKED"} {
+			if env.Exists(key) {
+				t.Errorf("Exists(%s) = true, want false", key)
+			}
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package env
+
+import (
+	"os"
+	"strings"
+)
+
+// Source provides the variables an Env reads.
+type Source interface {
+	Lookup(key string) (string, bool)
+}
+
+// Lister is implemented by sources that can enumerate the keys they hold.
+type Lister interface {
+	Keys() []string
+}
+
+type osSource struct{}
+
+func (osSource) Lookup(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
+func (osSource) Keys() []string {
+	environ := os.Environ()
+	keys := make([]string, 0, len(environ))
+	for _, pair := range environ {
+		if key, _, found := strings.Cut(pair, "="); found && key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// OS reads variables from the process environment.
+var OS Source = osSource{}
@@ -0,0 +1,40 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestOS(t *testing.T) {
+	os.Setenv("TEST_SOURCE_OS", "from_os")
+	defer os.Unsetenv("TEST_SOURCE_OS")
+
+	if got, exists := env.OS.Lookup("TEST_SOURCE_OS"); got != "from_os" || !exists {
+		t.Errorf("OS.Lookup() = %v, %v, want %v, %v", got, exists, "from_os", true)
+	}
+
+	if got, exists := env.OS.Lookup("TEST_SOURCE_OS_NOT_EXISTS"); got != "" || exists {
+		t.Errorf("OS.Lookup() = %v, %v, want %v, %v", got, exists, "", false)
+	}
+}
+
+func TestMapSource(t *testing.T) {
+	source := env.MapSource{"KEY": "value", "EMPTY": ""}
+
+	if got, exists := source.Lookup("KEY"); got != "value" || !exists {
+		t.Errorf("Lookup(KEY) = %v, %v, want %v, %v", got, exists, "value", true)
+	}
+	if got, exists := source.Lookup("EMPTY"); got != "" || !exists {
+		t.Errorf("Lookup(EMPTY) = %v, %v, want %v, %v", got, exists, "", true)
+	}
+	if got, exists := source.Lookup("MISSING"); got != "" || exists {
+		t.Errorf("Lookup(MISSING) = %v, %v, want %v, %v", got, exists, "", false)
+	}
+
+	var nilSource env.MapSource
+	if _, exists := nilSource.Lookup("KEY"); exists {
+		t.Errorf("nil MapSource Lookup() exists = true, want false")
+	}
+}
@@ -0,0 +1,193 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sampson-golang/utilities/strutil"
+)
+
+// Splitter describes how list and map values are split into elements.
+//
+// Unquoted elements are trimmed and have inner whitespace collapsed, like strutil.Squish.
+// Elements wrapped in single or double quotes are kept verbatim and may contain separators,
+// and a backslash escapes a quote, a backslash, or any character of Separator or Pair.
+type Splitter struct {
+	// Separator separates elements, "," by default.
+	Separator string
+	// Pair separates the key and value of map elements, "=" by default.
+	Pair string
+	// KeepEmpty keeps empty unquoted elements instead of dropping them.
+	// Quoted empty elements ("") are always kept.
+	KeepEmpty bool
+}
+
+// DefaultSplitter is used by List, Map, As, and Bind fields without separator tags.
+var DefaultSplitter = Splitter{Separator: ",", Pair: "="}
+
+// Split splits value into list elements. An empty value has no elements.
+func (s Splitter) Split(value string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts, err := s.cut(value, s.separator(), "", -1)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := make([]string, 0, len(parts))
+	for _, part := range parts {
+		element, quoted, err := s.unquote(part)
+		if err != nil {
+			return nil, err
+		}
+
+		if element != "" || quoted || s.KeepEmpty {
+			elements = append(elements, element)
+		}
+	}
+	return elements, nil
+}
+
+// SplitMap splits value into key/value pairs. Each element must contain the Pair separator,
+// and later duplicate keys replace earlier ones. An empty value has no pairs.
+func (s Splitter) SplitMap(value string) (map[string]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parts, err := s.cut(value, s.separator(), s.pair(), -1)
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make(map[string]string, len(parts))
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+
+		halves, err := s.cut(part, s.pair(), "", 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(halves) != 2 {
+			return nil, fmt.Errorf("missing %q in %q", s.pair(), strings.TrimSpace(part))
+		}
+
+		key, _, err := s.unquote(halves[0])
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			return nil, fmt.Errorf("empty key in %q", strings.TrimSpace(part))
+		}
+
+		pairs[key], _, err = s.unquote(halves[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pairs, nil
+}
+
+func (s Splitter) separator() string {
+	if s.Separator == "" {
+		return DefaultSplitter.Separator
+	}
+	return s.Separator
+}
+
+func (s Splitter) pair() string {
+	if s.Pair == "" {
+		return DefaultSplitter.Pair
+	}
+	return s.Pair
+}
+
+// cut splits value at each occurrence of sep that is neither escaped nor quoted,
+// returning at most limit parts when limit is positive. Parts are returned raw.
+// A quote may open a quoted section at the start of a part, or just after restart.
+func (s Splitter) cut(value string, sep string, restart string, limit int) ([]string, error) {
+	var parts []string
+	start := 0
+	quote := byte(0)
+	atStart := true
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		switch {
+		case c == '\\':
+			i++
+			atStart = false
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case atStart && (c == '"' || c == '\''):
+			quote = c
+			atStart = false
+		case strings.HasPrefix(value[i:], sep) && (limit <= 0 || len(parts) < limit-1):
+			parts = append(parts, value[start:i])
+			i += len(sep) - 1
+			start = i + 1
+			atStart = true
+		case restart != "" && strings.HasPrefix(value[i:], restart):
+			i += len(restart) - 1
+			atStart = true
+		case c == ' ' || c == '\t':
+		default:
+			atStart = false
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c-quoted element", quote)
+	}
+	return append(parts, value[start:]), nil
+}
+
+// unquote returns the value of a raw element, and whether it was quoted.
+func (s Splitter) unquote(raw string) (string, bool, error) {
+	raw = strings.TrimSpace(raw)
+
+	if raw == "" || (raw[0] != '"' && raw[0] != '\'') {
+		return strutil.Squish(s.unescape(raw)), false, nil
+	}
+
+	quote := raw[0]
+	for i := 1; i < len(raw); i++ {
+		switch raw[i] {
+		case '\\':
+			i++
+		case quote:
+			if i != len(raw)-1 {
+				return "", true, errors.New("unexpected characters after quoted element " + raw[:i+1])
+			}
+			return s.unescape(raw[1:i]), true, nil
+		}
+	}
+	return "", true, fmt.Errorf("unterminated %c-quoted element", quote)
+}
+
+// unescape drops the backslash from escaped quotes, backslashes, and separator characters.
+// Other backslashes are kept, so values such as Windows paths need no escaping.
+func (s Splitter) unescape(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	escapable := `\"'` + s.separator() + s.pair()
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) && strings.IndexByte(escapable, value[i+1]) >= 0 {
+			i++
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
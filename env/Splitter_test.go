@@ -0,0 +1,89 @@
+package env_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestSplitter_Split(t *testing.T) {
+	tests := []struct {
+		name     string
+		splitter env.Splitter
+		value    string
+		want     []string
+	}{
+		{"simple", env.DefaultSplitter, "a,b,c", []string{"a", "b", "c"}},
+		{"empty value", env.DefaultSplitter, "", nil},
+		{"squishes whitespace", env.DefaultSplitter, "  a ,  b   c\t, c ", []string{"a", "b c", "c"}},
+		{"drops empty elements", env.DefaultSplitter, "a,, ,b,", []string{"a", "b"}},
+		{"keeps empty elements", env.Splitter{KeepEmpty: true}, "a,,b", []string{"a", "", "b"}},
+		{"keeps quoted empty elements", env.DefaultSplitter, `a,"",b`, []string{"a", "", "b"}},
+		{"quoted separators", env.DefaultSplitter, `"a,b", 'c, d'`, []string{"a,b", "c, d"}},
+		{"quoted whitespace", env.DefaultSplitter, `"  a  b  "`, []string{"  a  b  "}},
+		{"escaped separators", env.DefaultSplitter, `a\,b,c`, []string{"a,b", "c"}},
+		{"escaped quotes", env.DefaultSplitter, `"say \"hi\"",\"b`, []string{`say "hi"`, `"b`}},
+		{"unrelated backslashes", env.DefaultSplitter, `C:\path,D:\\`, []string{`C:\path`, `D:\`}},
+		{"apostrophes", env.DefaultSplitter, "it's,fine", []string{"it's", "fine"}},
+		{"custom separator", env.Splitter{Separator: ";"}, "a,b;c", []string{"a,b", "c"}},
+		{"multi-character separator", env.Splitter{Separator: "::"}, "a:b::c", []string{"a:b", "c"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.splitter.Split(test.value)
+			if err != nil {
+				t.Fatalf("Split() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Split() = %q, want %q", got, test.want)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		for _, value := range []string{`"a,b`, `a,'b`, `"a"b,c`} {
+			if got, err := env.DefaultSplitter.Split(value); err == nil {
+				t.Errorf("Split(%q) = %q, want error", value, got)
+			}
+		}
+	})
+}
+
+func TestSplitter_SplitMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		splitter env.Splitter
+		value    string
+		want     map[string]string
+	}{
+		{"simple", env.DefaultSplitter, "team=core,tier=1", map[string]string{"team": "core", "tier": "1"}},
+		{"empty value", env.DefaultSplitter, "", nil},
+		{"splits at the first pair separator", env.DefaultSplitter, "query=a=b", map[string]string{"query": "a=b"}},
+		{"empty values", env.DefaultSplitter, "a=,b=1,", map[string]string{"a": "", "b": "1"}},
+		{"quoted values", env.DefaultSplitter, `note="a, b", "x=y"=z`, map[string]string{"note": "a, b", "x=y": "z"}},
+		{"last duplicate wins", env.DefaultSplitter, "a=1,a=2", map[string]string{"a": "2"}},
+		{"custom separators", env.Splitter{Separator: ";", Pair: ":"}, "a:1; b : 2", map[string]string{"a": "1", "b": "2"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.splitter.SplitMap(test.value)
+			if err != nil {
+				t.Fatalf("SplitMap() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("SplitMap() = %q, want %q", got, test.want)
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		for _, value := range []string{"a=1,b", "=1", `a="1`} {
+			if got, err := env.DefaultSplitter.SplitMap(value); err == nil {
+				t.Errorf("SplitMap(%q) = %q, want error", value, got)
+			}
+		}
+	})
+}
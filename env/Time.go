@@ -0,0 +1,27 @@
+package env
+
+import (
+	"time"
+)
+
+// Time looks up key like Lookup and parses the value with time.Parse using layout.
+// An unset or empty value yields the zero time.
+func Time(layout string, key string, fallbacks ...string) (time.Time, error) {
+	return std.Time(layout, key, fallbacks...)
+}
+
+func (e *Env) Time(layout string, key string, fallbacks ...string) (time.Time, error) {
+	lookup := e.LookupDetailed(key, fallbacks...)
+	if lookup.Err != nil {
+		return time.Time{}, lookup.Err
+	}
+	if lookup.Value == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse(layout, lookup.Value)
+	if err != nil {
+		return time.Time{}, &ParseError{Key: lookup.parseKey(), Value: lookup.Value, Err: err}
+	}
+	return parsed, nil
+}
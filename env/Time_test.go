@@ -0,0 +1,45 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestTime(t *testing.T) {
+	os.Setenv("TEST_TIME_VALUE", "2024-02-29")
+	defer os.Unsetenv("TEST_TIME_VALUE")
+	os.Setenv("TEST_TIME_BAD", "29/02/2024")
+	defer os.Unsetenv("TEST_TIME_BAD")
+
+	t.Run("parses with the layout", func(t *testing.T) {
+		got, err := env.Time(time.DateOnly, "TEST_TIME_VALUE")
+		want := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("Time() = %v, %v, want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("literal fallback", func(t *testing.T) {
+		got, err := env.Time(time.RFC3339, "TEST_TIME_NOT_EXISTS", "2024-01-01T12:00:00Z")
+		want := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("Time() = %v, %v, want %v, nil", got, err, want)
+		}
+	})
+
+	t.Run("unset is zero", func(t *testing.T) {
+		got, err := env.Time(time.DateOnly, "TEST_TIME_NOT_EXISTS")
+		if err != nil || !got.IsZero() {
+			t.Errorf("Time() = %v, %v, want zero, nil", got, err)
+		}
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		if got, err := env.Time(time.DateOnly, "TEST_TIME_BAD"); err == nil {
+			t.Errorf("Time() = %v, nil, want error", got)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package env
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Trail returns the resolution of every key read through the package-level functions,
+// in the order the keys were first read. Each key reports its most recent resolution.
+func Trail() []Result {
+	return std.Trail()
+}
+
+// Trail returns the resolution of every key read through this Env, and any Env derived from it with WithPrefix.
+// Values that Config redacts are replaced with Redacted here too.
+func (e *Env) Trail() []Result {
+	trail := e.trail.list()
+	for i := range trail {
+		if e.redacts(trail[i]) {
+			trail[i].Value = Redacted
+		}
+	}
+	return trail
+}
+
+// DumpTrail writes one line per key in Trail, describing where its value came from.
+// Secret values are redacted like Config.
+func DumpTrail(w io.Writer) error {
+	return std.DumpTrail(w)
+}
+
+func (e *Env) DumpTrail(w io.Writer) error {
+	for _, result := range e.Trail() {
+		line := fmt.Sprintf("%s = %q (%s", result.Keys[0], result.Value, result.Source())
+		if result.Empty {
+			line += ", empty"
+		}
+		if result.Deprecated {
+			line += ", deprecated"
+		}
+		if len(result.Keys) > 1 {
+			line += "; tried " + strings.Join(result.Keys, ", ")
+		}
+
+		if _, err := fmt.Fprintln(w, line+")"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package env_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestTrail(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"APP_PORT": "9090",
+		"DB_URL":   "postgres://db",
+		"EMPTY":    "",
+	})
+
+	e.Get("DATABASE_URL", "DB_URL", "")
+	e.WithPrefix("APP_").Get("PORT")
+	e.Get("EMPTY")
+	e.Get("TIMEOUT", "30s")
+	e.Get("DATABASE_URL", "DB_URL", "")
+
+	t.Run("records each key once, in order", func(t *testing.T) {
+		trail := e.Trail()
+
+		var keys []string
+		for _, result := range trail {
+			keys = append(keys, result.Keys[0])
+		}
+
+		want := []string{"DATABASE_URL", "APP_PORT", "EMPTY", "TIMEOUT"}
+		if strings.Join(keys, ",") != strings.Join(want, ",") {
+			t.Errorf("Trail() keys = %v, want %v", keys, want)
+		}
+	})
+
+	t.Run("DumpTrail", func(t *testing.T) {
+		var b strings.Builder
+		if err := e.DumpTrail(&b); err != nil {
+			t.Fatalf("DumpTrail() error = %v", err)
+		}
+
+		want := strings.Join([]string{
+			`DATABASE_URL = "postgres://db" (DB_URL; tried DATABASE_URL, DB_URL)`,
+			`APP_PORT = "9090" (APP_PORT)`,
+			`EMPTY = "" (EMPTY, empty)`,
+			`TIMEOUT = "30s" (default)`,
+		}, "\n") + "\n"
+
+		if got := b.String(); got != want {
+			t.Errorf("DumpTrail() =\n%s\nwant\n%s", got, want)
+		}
+	})
+
+	t.Run("is not shared between environments", func(t *testing.T) {
+		if got := env.New(env.MapSource{}).Trail(); len(got) != 0 {
+			t.Errorf("Trail() = %v, want empty", got)
+		}
+	})
+}
+
+func TestTrail_RedactsSecrets(t *testing.T) {
+	t.Parallel()
+
+	e := env.New(env.MapSource{
+		"APP_DB_PASSWORD": "hunter2",
+		"APP_API":         "s3cr3t",
+		"APP_SIGNING":     "k3y",
+		"APP_HOST":        "localhost",
+	})
+	app := e.WithPrefix("APP_")
+
+	app.Get("DB_PASSWORD")
+	app.LookupSecret("API")
+	var config struct {
+		Signing string `env:"SIGNING" secret:"true"`
+		Host    string `env:"HOST"`
+	}
+	if err := app.Bind(&config); err != nil {
+		t.Fatalf("Bind() error = %v", err)
+	}
+
+	trail := e.Trail()
+	if len(trail) != 4 {
+		t.Fatalf("Trail() = %+v, want 4 results", trail)
+	}
+	for _, result := range trail {
+		want := env.Redacted
+		if result.Keys[0] == "APP_HOST" {
+			want = "localhost"
+		}
+		if result.Value != want {
+			t.Errorf("Trail() %s = %q, want %q", result.Keys[0], result.Value, want)
+		}
+	}
+
+	var b strings.Builder
+	if err := e.DumpTrail(&b); err != nil {
+		t.Fatalf("DumpTrail() error = %v", err)
+	}
+	for _, secret := range []string{"hunter2", "s3cr3t", "k3y"} {
+		if strings.Contains(b.String(), secret) {
+			t.Errorf("DumpTrail() leaked %q:\n%s", secret, b.String())
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package env
+
+import (
+	"net/url"
+)
+
+// URL looks up key like Lookup and parses the value with url.Parse.
+// An unset or empty value yields a nil URL.
+func URL(key string, fallbacks ...string) (*url.URL, error) {
+	return std.URL(key, fallbacks...)
+}
+
+func (e *Env) URL(key string, fallbacks ...string) (*url.URL, error) {
+	lookup := e.LookupDetailed(key, fallbacks...)
+	if lookup.Err != nil {
+		return nil, lookup.Err
+	}
+	if lookup.Value == "" {
+		return nil, nil
+	}
+
+	parsed, err := url.Parse(lookup.Value)
+	if err != nil {
+		return nil, &ParseError{Key: lookup.parseKey(), Value: lookup.Value, Err: unwrapURLError(err)}
+	}
+	return parsed, nil
+}
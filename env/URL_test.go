@@ -0,0 +1,45 @@
+package env_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestURL(t *testing.T) {
+	os.Setenv("TEST_URL_VALUE", "postgres://db.internal:5432/app")
+	defer os.Unsetenv("TEST_URL_VALUE")
+	os.Setenv("TEST_URL_BAD", "://missing-scheme")
+	defer os.Unsetenv("TEST_URL_BAD")
+
+	t.Run("parses the value", func(t *testing.T) {
+		got, err := env.URL("TEST_URL_VALUE")
+		if err != nil {
+			t.Fatalf("URL() error = %v", err)
+		}
+		if got.Scheme != "postgres" || got.Host != "db.internal:5432" || got.Path != "/app" {
+			t.Errorf("URL() got = %v", got)
+		}
+	})
+
+	t.Run("literal fallback", func(t *testing.T) {
+		got, err := env.URL("TEST_URL_NOT_EXISTS", "http://localhost")
+		if err != nil || got.String() != "http://localhost" {
+			t.Errorf("URL() = %v, %v, want http://localhost, nil", got, err)
+		}
+	})
+
+	t.Run("unset is nil", func(t *testing.T) {
+		got, err := env.URL("TEST_URL_NOT_EXISTS")
+		if err != nil || got != nil {
+			t.Errorf("URL() = %v, %v, want nil, nil", got, err)
+		}
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		if got, err := env.URL("TEST_URL_BAD"); err == nil {
+			t.Errorf("URL() = %v, nil, want error", got)
+		}
+	})
+}
@@ -0,0 +1,314 @@
+package env
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Change describes a variable whose value changed when a Watcher reloaded its files.
+type Change struct {
+	Key string
+	Old string
+	New string
+	// Added is true when Key was not set before the reload.
+	Added bool
+	// Removed is true when Key is no longer set after the reload.
+	Removed bool
+}
+
+// Watcher is a Source backed by files that are polled for changes, so long-running
+// processes pick up rotated credentials without restarting. Later files take precedence.
+// It is safe for concurrent use, and readers always see a complete set of values.
+type Watcher struct {
+	interval time.Duration
+
+	mutex  sync.RWMutex
+	values map[string]string
+
+	reloading sync.Mutex
+	files     []*watchedFile
+	logger    Logger
+
+	subscribing sync.Mutex
+	subscribers map[int]func([]Change)
+	nextID      int
+
+	start sync.Once
+	stop  sync.Once
+	done  chan struct{}
+}
+
+// watchedFile is a dotenv file, or a secret file holding the value of key.
+type watchedFile struct {
+	path    string
+	key     string
+	loaded  bool
+	content []byte // the last contents of a dotenv file
+	values  map[string]string
+}
+
+// NewWatcher returns a Watcher that, once started, polls its files every interval.
+// An interval of zero or less disables polling, leaving Reload to check the files.
+func NewWatcher(interval time.Duration) *Watcher {
+	return &Watcher{
+		interval:    interval,
+		values:      map[string]string{},
+		subscribers: map[int]func([]Change){},
+		done:        make(chan struct{}),
+	}
+}
+
+// AddFile reads the dotenv file at path, which is parsed and expanded like Parse,
+// and watches it for changes.
+func (w *Watcher) AddFile(path string) error {
+	return w.add(&watchedFile{path: path})
+}
+
+// AddSecret reads the file at path as the value of key, like a KEY_FILE variable
+// read by LookupSecret, and watches it for changes.
+func (w *Watcher) AddSecret(key string, path string) error {
+	return w.add(&watchedFile{path: path, key: key})
+}
+
+func (w *Watcher) add(file *watchedFile) error {
+	w.reloading.Lock()
+	defer w.reloading.Unlock()
+
+	if _, err := file.reload(); err != nil {
+		return err
+	}
+
+	w.files = append(w.files, file)
+	w.notify(w.publish())
+	return nil
+}
+
+// SetLogger sets the Logger warned when a watched file cannot be reloaded,
+// in which case its previous values are kept. A nil logger uses slog.Default().
+func (w *Watcher) SetLogger(logger Logger) {
+	w.reloading.Lock()
+	defer w.reloading.Unlock()
+
+	w.logger = logger
+}
+
+// Subscribe registers fn to be called with the changed variables, sorted by key,
+// after each reload that changes any. Calls are made one at a time, after the new values
+// are visible to readers. Since they are made while the Watcher is reloading, fn must not
+// call Reload, AddFile, AddSecret or SetLogger, which would deadlock; reading values is safe.
+// The returned function unsubscribes fn.
+func (w *Watcher) Subscribe(fn func([]Change)) (unsubscribe func()) {
+	w.subscribing.Lock()
+	defer w.subscribing.Unlock()
+
+	id := w.nextID
+	w.nextID++
+	w.subscribers[id] = fn
+
+	return func() {
+		w.subscribing.Lock()
+		defer w.subscribing.Unlock()
+
+		delete(w.subscribers, id)
+	}
+}
+
+// Start begins polling in a new goroutine. Calling Start more than once, or on a Watcher
+// whose interval is zero or less, has no effect.
+func (w *Watcher) Start() {
+	if w.interval <= 0 {
+		return
+	}
+
+	w.start.Do(func() {
+		go w.poll()
+	})
+}
+
+// Stop stops polling. The Watcher keeps serving the values it last read.
+func (w *Watcher) Stop() {
+	w.stop.Do(func() {
+		close(w.done)
+	})
+}
+
+func (w *Watcher) poll() {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			w.Reload()
+		}
+	}
+}
+
+// Reload checks every file immediately, returning the first error encountered.
+// Files that cannot be read or parsed keep their previous values, and the error is
+// also sent to the Logger. Subscribers are notified before Reload returns.
+func (w *Watcher) Reload() error {
+	w.reloading.Lock()
+	defer w.reloading.Unlock()
+
+	var first error
+	changed := false
+
+	for _, file := range w.files {
+		updated, err := file.reload()
+		if err != nil {
+			w.warn(file, err)
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		changed = changed || updated
+	}
+
+	if changed {
+		w.notify(w.publish())
+	}
+	return first
+}
+
+func (w *Watcher) warn(file *watchedFile, err error) {
+	logger := w.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.Warn("env: reloading watched file failed", "path", file.path, "error", err)
+}
+
+// publish merges the values of every file, swaps them in for readers, and returns what changed.
+func (w *Watcher) publish() []Change {
+	values := map[string]string{}
+	for _, file := range w.files {
+		for key, value := range file.values {
+			values[key] = value
+		}
+	}
+
+	w.mutex.Lock()
+	old := w.values
+	w.values = values
+	w.mutex.Unlock()
+
+	var changes []Change
+	for key, value := range values {
+		previous, existed := old[key]
+		if !existed || previous != value {
+			changes = append(changes, Change{Key: key, Old: previous, New: value, Added: !existed})
+		}
+	}
+	for key, previous := range old {
+		if _, exists := values[key]; !exists {
+			changes = append(changes, Change{Key: key, Old: previous, Removed: true})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+func (w *Watcher) notify(changes []Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	w.subscribing.Lock()
+	ids := make([]int, 0, len(w.subscribers))
+	for id := range w.subscribers {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	subscribers := make([]func([]Change), len(ids))
+	for i, id := range ids {
+		subscribers[i] = w.subscribers[id]
+	}
+	w.subscribing.Unlock()
+
+	for _, fn := range subscribers {
+		fn(changes)
+	}
+}
+
+func (w *Watcher) Lookup(key string) (string, bool) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	value, exists := w.values[key]
+	return value, exists
+}
+
+func (w *Watcher) Keys() []string {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	keys := make([]string, 0, len(w.values))
+	for key := range w.values {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// reload re-reads the file, reporting whether its contents changed.
+func (f *watchedFile) reload() (bool, error) {
+	if f.key != "" {
+		return f.reloadSecret()
+	}
+
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		return false, err
+	}
+
+	if f.loaded && bytes.Equal(content, f.content) {
+		return false, nil
+	}
+
+	entries, err := parseDotenv(string(content))
+	if err != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			syntaxErr.File = f.path
+		}
+		return false, err
+	}
+
+	values, err := expandEntries(entries)
+	if err != nil {
+		return false, err
+	}
+
+	f.loaded = true
+	f.content = content
+	f.values = values
+	return true, nil
+}
+
+// reloadSecret re-reads a secret file like LookupSecret, reporting whether its value changed.
+func (f *watchedFile) reloadSecret() (bool, error) {
+	value, err := readSecret(f.path)
+	if err != nil {
+		return false, &SecretError{Key: f.key, Path: f.path, Err: err}
+	}
+
+	if f.loaded && f.values[f.key] == value {
+		return false, nil
+	}
+
+	f.loaded = true
+	f.values = map[string]string{f.key: value}
+	return true, nil
+}
@@ -0,0 +1,170 @@
+package env_test
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestWatcher(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := writeFile(t, dir, ".env", "HOST=localhost\nPORT=8080\nDB_PASSWORD=from_env_file\n")
+	secret := writeFile(t, dir, "db_password", "hunter2\n")
+
+	w := env.NewWatcher(10 * time.Millisecond)
+	w.SetLogger(&deprecateTestLogger{})
+	if err := w.AddFile(config); err != nil {
+		t.Fatalf("AddFile() error = %v", err)
+	}
+	if err := w.AddSecret("DB_PASSWORD", secret); err != nil {
+		t.Fatalf("AddSecret() error = %v", err)
+	}
+
+	e := env.New(w)
+
+	t.Run("serves the files", func(t *testing.T) {
+		if got := e.Get("HOST"); got != "localhost" {
+			t.Errorf("Get(HOST) = %v, want %v", got, "localhost")
+		}
+		if got := e.Get("DB_PASSWORD"); got != "hunter2" {
+			t.Errorf("Get(DB_PASSWORD) = %v, want %v", got, "hunter2")
+		}
+	})
+
+	t.Run("Reload reports changes", func(t *testing.T) {
+		var got []env.Change
+		unsubscribe := w.Subscribe(func(changes []env.Change) {
+			got = changes
+		})
+		defer unsubscribe()
+
+		writeFile(t, dir, ".env", "HOST=db.internal\nDEBUG=true\nDB_PASSWORD=from_env_file\n")
+		if err := w.Reload(); err != nil {
+			t.Fatalf("Reload() error = %v", err)
+		}
+
+		want := []env.Change{
+			{Key: "DEBUG", New: "true", Added: true},
+			{Key: "HOST", Old: "localhost", New: "db.internal"},
+			{Key: "PORT", Old: "8080", Removed: true},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("changes = %+v, want %+v", got, want)
+		}
+		if got := e.Get("HOST"); got != "db.internal" {
+			t.Errorf("Get(HOST) = %v, want %v", got, "db.internal")
+		}
+
+		got = nil
+		if err := w.Reload(); err != nil || got != nil {
+			t.Errorf("Reload() = %v with changes %+v, want no changes", err, got)
+		}
+	})
+
+	t.Run("keeps previous values when a file breaks", func(t *testing.T) {
+		writeFile(t, dir, ".env", "NOT VALID\n")
+		if err := w.Reload(); err == nil {
+			t.Errorf("Reload() error = nil, want syntax error")
+		}
+		if got := e.Get("HOST"); got != "db.internal" {
+			t.Errorf("Get(HOST) = %v, want %v", got, "db.internal")
+		}
+		writeFile(t, dir, ".env", "HOST=db.internal\nDEBUG=true\nDB_PASSWORD=from_env_file\n")
+	})
+
+	t.Run("polls for rotated secrets", func(t *testing.T) {
+		changed := make(chan []env.Change, 1)
+		unsubscribe := w.Subscribe(func(changes []env.Change) {
+			changed <- changes
+		})
+		defer unsubscribe()
+
+		w.Start()
+		defer w.Stop()
+
+		var readers sync.WaitGroup
+		stop := make(chan struct{})
+		for i := 0; i < 4; i++ {
+			readers.Add(1)
+			go func() {
+				defer readers.Done()
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+						if got := e.Get("DB_PASSWORD"); got != "hunter2" && got != "correct-horse" {
+							t.Errorf("Get(DB_PASSWORD) = %v during rotation", got)
+							return
+						}
+					}
+				}
+			}()
+		}
+
+		writeFile(t, dir, "db_password", "correct-horse\n")
+
+		select {
+		case changes := <-changed:
+			want := []env.Change{{Key: "DB_PASSWORD", Old: "hunter2", New: "correct-horse"}}
+			if !reflect.DeepEqual(changes, want) {
+				t.Errorf("changes = %+v, want %+v", changes, want)
+			}
+		case <-time.After(2 * time.Second):
+			t.Errorf("no change reported after rotating the secret")
+		}
+
+		close(stop)
+		readers.Wait()
+	})
+
+	t.Run("rejects unreadable files", func(t *testing.T) {
+		if err := env.NewWatcher(time.Second).AddFile(filepath.Join(dir, "missing")); err == nil {
+			t.Errorf("AddFile() error = nil, want error")
+		}
+	})
+}
+
+func TestWatcher_SecretTooLarge(t *testing.T) {
+	t.Parallel()
+
+	secret := writeFile(t, t.TempDir(), "secret", strings.Repeat("x", int(env.MaxSecretSize)+1))
+
+	w := env.NewWatcher(time.Minute)
+	err := w.AddSecret("DB_PASSWORD", secret)
+
+	var secretErr *env.SecretError
+	if !errors.As(err, &secretErr) || !errors.Is(err, env.ErrSecretTooLarge) {
+		t.Errorf("AddSecret() error = %v, want a *SecretError wrapping ErrSecretTooLarge", err)
+	}
+}
+
+func TestWatcher_NonPositiveInterval(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	config := writeFile(t, dir, ".env", "HOST=localhost\n")
+
+	w := env.NewWatcher(0)
+	if err := w.AddFile(config); err != nil {
+		t.Fatalf("AddFile() error = %v", err)
+	}
+	w.Start()
+	defer w.Stop()
+
+	writeFile(t, dir, ".env", "HOST=db.internal\n")
+	if err := w.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+	if got, _ := w.Lookup("HOST"); got != "db.internal" {
+		t.Errorf("Lookup(HOST) = %v, want %v", got, "db.internal")
+	}
+}
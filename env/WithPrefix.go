@@ -0,0 +1,22 @@
+package env
+
+// WithPrefix returns an Env whose keys, including fallback keys, are all prefixed with prefix.
+// Literal fallback values are not affected.
+func WithPrefix(prefix string) *Env {
+	return std.WithPrefix(prefix)
+}
+
+func (e *Env) WithPrefix(prefix string) *Env {
+	scoped := *e
+	scoped.prefix = e.prefix + prefix
+	return &scoped
+}
+
+// prefixed returns keys with the Env's prefix, as the source sees them.
+func (e *Env) prefixed(keys []string) []string {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = e.prefix + key
+	}
+	return names
+}
@@ -0,0 +1,120 @@
+package env_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestWithPrefix(t *testing.T) {
+	os.Setenv("TEST_PREFIX_WORKER_CONCURRENCY", "4")
+	defer os.Unsetenv("TEST_PREFIX_WORKER_CONCURRENCY")
+	os.Setenv("TEST_PREFIX_WORKER_QUEUE", "jobs")
+	defer os.Unsetenv("TEST_PREFIX_WORKER_QUEUE")
+	os.Setenv("TEST_PREFIX_WORKER_EMPTY", "")
+	defer os.Unsetenv("TEST_PREFIX_WORKER_EMPTY")
+	os.Setenv("CONCURRENCY", "unprefixed")
+	defer os.Unsetenv("CONCURRENCY")
+
+	worker := env.WithPrefix("TEST_PREFIX_WORKER_")
+
+	t.Run("Lookup reads prefixed keys", func(t *testing.T) {
+		got, exists := worker.Lookup("CONCURRENCY")
+		if got != "4" || !exists {
+			t.Errorf("Lookup() = %v, %v, want %v, %v", got, exists, "4", true)
+		}
+
+		if got := worker.Get("NOT_EXISTS"); got != "" {
+			t.Errorf("Get() = %v, want %v", got, "")
+		}
+	})
+
+	t.Run("fallback keys are prefixed", func(t *testing.T) {
+		if got := worker.Get("NOT_EXISTS", "QUEUE", "default"); got != "jobs" {
+			t.Errorf("Get() = %v, want %v", got, "jobs")
+		}
+
+		if got := worker.Get("NOT_EXISTS", "CONCURRENCY_TYPO", "default"); got != "default" {
+			t.Errorf("Get() = %v, want %v", got, "default")
+		}
+	})
+
+	t.Run("literal fallback is not prefixed", func(t *testing.T) {
+		if got := worker.Get("NOT_EXISTS", "CONCURRENCY"); got != "CONCURRENCY" {
+			t.Errorf("Get() = %v, want %v", got, "CONCURRENCY")
+		}
+	})
+
+	t.Run("LookupPresent and Exists", func(t *testing.T) {
+		if got := worker.GetPresent("EMPTY", "QUEUE", "default"); got != "jobs" {
+			t.Errorf("GetPresent() = %v, want %v", got, "jobs")
+		}
+		if !worker.Exists("EMPTY") {
+			t.Errorf("Exists(EMPTY) = false, want true")
+		}
+		if worker.Exists("TEST_PREFIX_WORKER_QUEUE") {
+			t.Errorf("Exists(TEST_PREFIX_WORKER_QUEUE) = true, want false")
+		}
+	})
+
+	t.Run("prefixes nest", func(t *testing.T) {
+		nested := env.WithPrefix("TEST_PREFIX_").WithPrefix("WORKER_")
+		if got := nested.Get("QUEUE"); got != "jobs" {
+			t.Errorf("Get() = %v, want %v", got, "jobs")
+		}
+	})
+
+	t.Run("applies to custom sources", func(t *testing.T) {
+		scoped := env.New(env.MapSource{"APP_PORT": "8080", "PORT": "9090"}).WithPrefix("APP_")
+		if got := scoped.Get("PORT"); got != "8080" {
+			t.Errorf("Get() = %v, want %v", got, "8080")
+		}
+	})
+
+	t.Run("Bind uses the prefix", func(t *testing.T) {
+		var config struct {
+			Concurrency int    `env:"CONCURRENCY"`
+			Queue       string `env:"QUEUE"`
+		}
+
+		if err := worker.Bind(&config); err != nil {
+			t.Fatalf("Bind() error = %v", err)
+		}
+		if config.Concurrency != 4 || config.Queue != "jobs" {
+			t.Errorf("Bind() = %+v", config)
+		}
+	})
+}
+
+func TestWithPrefix_Errors(t *testing.T) {
+	t.Parallel()
+
+	app := env.New(env.MapSource{
+		"APP_DB_FILE": filepath.Join(t.TempDir(), "missing"),
+	}).WithPrefix("APP_")
+
+	t.Run("MissingError names prefixed keys", func(t *testing.T) {
+		var config struct {
+			DatabaseURL string `env:"DATABASE_URL,DB_URL" required:"true"`
+		}
+
+		var missing *env.MissingError
+		if err := app.Bind(&config); !errors.As(err, &missing) {
+			t.Fatalf("Bind() error = %v, want *env.MissingError", err)
+		}
+		if want := []string{"APP_DATABASE_URL", "APP_DB_URL"}; !reflect.DeepEqual(missing.Keys, want) {
+			t.Errorf("MissingError.Keys = %v, want %v", missing.Keys, want)
+		}
+	})
+
+	t.Run("SecretError names the prefixed key", func(t *testing.T) {
+		var secretErr *env.SecretError
+		if _, _, err := app.LookupSecret("DB"); !errors.As(err, &secretErr) || secretErr.Key != "APP_DB_FILE" {
+			t.Errorf("LookupSecret() error = %v, want *env.SecretError for APP_DB_FILE", err)
+		}
+	})
+}
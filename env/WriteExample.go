@@ -0,0 +1,53 @@
+package env
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteExample writes a .env.example file documenting every variable bound by the struct
+// pointed to by target, in field order. Each variable is set to its default, and preceded
+// by comments holding its `description` tag, whether it is required, and its fallback keys.
+func WriteExample(w io.Writer, target interface{}) error {
+	fields, err := fields(target)
+	if err != nil {
+		return err
+	}
+
+	for i, f := range fields {
+		var lines []string
+		if i > 0 {
+			lines = append(lines, "")
+		}
+
+		if description := f.description(); description != "" {
+			lines = append(lines, "# "+strings.ReplaceAll(description, "\n", "\n# "))
+		}
+		if f.required() {
+			lines = append(lines, "# Required.")
+		}
+		if len(f.keys) > 1 {
+			lines = append(lines, "# Also read from "+strings.Join(f.keys[1:], ", ")+".")
+		}
+
+		def, _ := f.defaultValue()
+		lines = append(lines, f.keys[0]+"="+exampleValue(def))
+
+		if _, err := fmt.Fprintln(w, strings.Join(lines, "\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exampleValue formats value for a dotenv file, double-quoting it
+// when it would not otherwise be read back verbatim.
+func exampleValue(value string) string {
+	if !strings.ContainsAny(value, " \t\r\n#'\"`\\$") {
+		return value
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "$", `\$`)
+	return `"` + replacer.Replace(value) + `"`
+}
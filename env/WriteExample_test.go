@@ -0,0 +1,60 @@
+package env_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+type docsTestConfig struct {
+	DatabaseURL string `env:"DATABASE_URL,DB_URL" required:"true" description:"Postgres connection string."`
+	Port        int    `env:"PORT" default:"8080" description:"Port to listen on | serve from."`
+	Greeting    string `env:"GREETING" default:"hello, $USER #1"`
+	Debug       bool   `env:"DEBUG"`
+	Cache       struct {
+		TTL string `env:"TTL" default:"5m" description:"How long entries live."`
+	} `envPrefix:"CACHE_"`
+}
+
+func TestWriteExample(t *testing.T) {
+	var b strings.Builder
+	if err := env.WriteExample(&b, &docsTestConfig{}); err != nil {
+		t.Fatalf("WriteExample() error = %v", err)
+	}
+
+	want := `# Postgres connection string.
+# Required.
+# Also read from DB_URL.
+DATABASE_URL=
+
+# Port to listen on | serve from.
+PORT=8080
+
+GREETING="hello, \$USER #1"
+
+DEBUG=
+
+# How long entries live.
+CACHE_TTL=5m
+`
+	if got := b.String(); got != want {
+		t.Errorf("WriteExample() =\n%s\nwant\n%s", got, want)
+	}
+
+	t.Run("round trips through Parse", func(t *testing.T) {
+		values, err := env.Parse(strings.NewReader(b.String()))
+		if err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+		if values["GREETING"] != "hello, $USER #1" {
+			t.Errorf("GREETING = %q, want %q", values["GREETING"], "hello, $USER #1")
+		}
+	})
+
+	t.Run("requires a struct pointer", func(t *testing.T) {
+		if err := env.WriteExample(&b, docsTestConfig{}); err == nil {
+			t.Errorf("WriteExample(struct) error = nil, want error")
+		}
+	})
+}
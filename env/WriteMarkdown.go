@@ -0,0 +1,54 @@
+package env
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown writes a markdown table documenting every variable bound by the struct
+// pointed to by target, with its fallback keys, default, whether it is required,
+// and its `description` tag.
+func WriteMarkdown(w io.Writer, target interface{}) error {
+	fields, err := fields(target)
+	if err != nil {
+		return err
+	}
+
+	lines := []string{
+		"| Variable | Default | Required | Description |",
+		"| --- | --- | --- | --- |",
+	}
+
+	for _, f := range fields {
+		keys := make([]string, len(f.keys))
+		for i, key := range f.keys {
+			keys[i] = "`" + key + "`"
+		}
+
+		def := ""
+		if value, ok := f.defaultValue(); ok {
+			def = "`" + value + "`"
+		}
+
+		required := ""
+		if f.required() {
+			required = "yes"
+		}
+
+		cells := []string{strings.Join(keys, ", "), def, required, f.description()}
+		for i, cell := range cells {
+			cells[i] = markdownCell(cell)
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+	}
+
+	_, err = fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
+
+// markdownCell escapes pipes and flattens newlines so text fits in a table cell.
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(text, "\n", "<br>")
+}
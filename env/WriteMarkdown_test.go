@@ -0,0 +1,27 @@
+package env_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sampson-golang/utilities/env"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	var b strings.Builder
+	if err := env.WriteMarkdown(&b, &docsTestConfig{}); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+
+	want := "| Variable | Default | Required | Description |\n" +
+		"| --- | --- | --- | --- |\n" +
+		"| `DATABASE_URL`, `DB_URL` |  | yes | Postgres connection string. |\n" +
+		"| `PORT` | `8080` |  | Port to listen on \\| serve from. |\n" +
+		"| `GREETING` | `hello, $USER #1` |  |  |\n" +
+		"| `DEBUG` |  |  |  |\n" +
+		"| `CACHE_TTL` | `5m` |  | How long entries live. |\n"
+
+	if got := b.String(); got != want {
+		t.Errorf("WriteMarkdown() =\n%s\nwant\n%s", got, want)
+	}
+}
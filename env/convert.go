@@ -0,0 +1,183 @@
+package env
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sampson-golang/utilities/boolable"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	urlType             = reflect.TypeOf(url.URL{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// isLeaf reports whether values of type t are parsed from a single variable
+// rather than walked as a nested configuration struct.
+func isLeaf(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Kind() != reflect.Struct || t == urlType || reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// integerBase returns the base to parse value in: decimal, so zero-padded values
+// keep their meaning, unless value has an explicit 0x, 0o or 0b prefix.
+func integerBase(value string) int {
+	digits := strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+	if len(digits) > 2 && digits[0] == '0' && strings.ContainsRune("xXoObB", rune(digits[1])) {
+		return 0
+	}
+	return 10
+}
+
+// convert converts value into target, which must be settable.
+// Pointers are allocated as needed, encoding.TextUnmarshaler implementations
+// are honoured, and empty values leave non-string targets at their zero value.
+// Slices and maps are split with DefaultSplitter.
+func convert(value string, target reflect.Value) error {
+	return convertWith(value, target, DefaultSplitter)
+}
+
+// convertWith is convert, splitting slices and maps with splitter.
+func convertWith(value string, target reflect.Value, splitter Splitter) error {
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return convertWith(value, target.Elem(), splitter)
+	}
+
+	if target.CanAddr() && target.Addr().Type().Implements(textUnmarshalerType) {
+		return target.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+
+	if value == "" && target.Kind() != reflect.String {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	switch target.Type() {
+	case durationType:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		target.SetInt(int64(parsed))
+		return nil
+	case urlType:
+		parsed, err := url.Parse(value)
+		if err != nil {
+			return unwrapURLError(err)
+		}
+		target.Set(reflect.ValueOf(*parsed))
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(value)
+	case reflect.Bool:
+		parsed, err := boolable.Parse(value)
+		if err != nil {
+			return boolable.ErrInvalid
+		}
+		target.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, integerBase(value), target.Type().Bits())
+		if err != nil {
+			return unwrapNumError(err)
+		}
+		target.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, integerBase(value), target.Type().Bits())
+		if err != nil {
+			return unwrapNumError(err)
+		}
+		target.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, target.Type().Bits())
+		if err != nil {
+			return unwrapNumError(err)
+		}
+		target.SetFloat(parsed)
+	case reflect.Slice:
+		if target.Type().Elem().Kind() == reflect.Uint8 {
+			target.SetBytes([]byte(value))
+			return nil
+		}
+		return convertSlice(value, target, splitter)
+	case reflect.Map:
+		return convertMap(value, target, splitter)
+	default:
+		return fmt.Errorf("unsupported type %s", target.Type())
+	}
+
+	return nil
+}
+
+func convertSlice(value string, target reflect.Value, splitter Splitter) error {
+	elements, err := splitter.Split(value)
+	if err != nil {
+		return err
+	}
+
+	slice := reflect.MakeSlice(target.Type(), len(elements), len(elements))
+	for i, element := range elements {
+		if err := convertWith(element, slice.Index(i), splitter); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+
+	target.Set(slice)
+	return nil
+}
+
+func convertMap(value string, target reflect.Value, splitter Splitter) error {
+	pairs, err := splitter.SplitMap(value)
+	if err != nil {
+		return err
+	}
+
+	mapType := target.Type()
+	result := reflect.MakeMapWithSize(mapType, len(pairs))
+	for k, v := range pairs {
+		key := reflect.New(mapType.Key()).Elem()
+		if err := convertWith(k, key, splitter); err != nil {
+			return fmt.Errorf("key %q: %w", k, err)
+		}
+
+		elem := reflect.New(mapType.Elem()).Elem()
+		if err := convertWith(v, elem, splitter); err != nil {
+			return fmt.Errorf("value of %s: %w", k, err)
+		}
+
+		result.SetMapIndex(key, elem)
+	}
+
+	target.Set(result)
+	return nil
+}
+
+// unwrapNumError drops the strconv wrapper, whose message repeats the value.
+func unwrapNumError(err error) error {
+	if numErr, ok := err.(*strconv.NumError); ok {
+		return numErr.Err
+	}
+	return err
+}
+
+// unwrapURLError drops the url.Error wrapper, whose message repeats the value.
+func unwrapURLError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		return urlErr.Err
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+package env
+
+import (
+	"log/slog"
+	"sync"
+)
+
+// deprecation is an old variable name that still resolves in place of its replacement.
+type deprecation struct {
+	key         string
+	replacement string
+	removal     string
+}
+
+// deprecations holds the deprecated aliases declared on an Env,
+// and remembers which of them have already been warned about.
+type deprecations struct {
+	mutex    sync.RWMutex
+	byKey    map[string]deprecation
+	byTarget map[string][]deprecation
+	warned   map[string]bool
+	logger   Logger
+	strict   bool
+}
+
+func newDeprecations() *deprecations {
+	return &deprecations{
+		byKey:    map[string]deprecation{},
+		byTarget: map[string][]deprecation{},
+		warned:   map[string]bool{},
+	}
+}
+
+func (d *deprecations) add(dep deprecation) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if _, exists := d.byKey[dep.key]; !exists {
+		d.byTarget[dep.replacement] = append(d.byTarget[dep.replacement], dep)
+	}
+	d.byKey[dep.key] = dep
+}
+
+// aliases returns the deprecated names that resolve in place of key.
+func (d *deprecations) aliases(key string) []deprecation {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.byTarget[key]
+}
+
+// check is called when key satisfies a lookup. If key is deprecated, it warns once,
+// and in strict mode returns a *DeprecatedError refusing the value.
+func (d *deprecations) check(key string) (bool, error) {
+	d.mutex.Lock()
+	dep, deprecated := d.byKey[key]
+	warn := deprecated && !d.warned[key]
+	if warn {
+		d.warned[key] = true
+	}
+	logger, strict := d.logger, d.strict
+	d.mutex.Unlock()
+
+	if !deprecated {
+		return false, nil
+	}
+
+	if warn {
+		if logger == nil {
+			logger = slog.Default()
+		}
+		logger.Warn("env: deprecated variable is set", "key", dep.key, "replacement", dep.replacement, "removal", dep.removal, "strict", strict)
+	}
+
+	if strict {
+		return true, &DeprecatedError{Key: dep.key, Replacement: dep.replacement, Removal: dep.removal}
+	}
+	return true, nil
+}
@@ -0,0 +1,236 @@
+package env
+
+import (
+	"errors"
+	"os"
+	"strings"
+)
+
+// entry is a single assignment read from a dotenv file.
+type entry struct {
+	key   string
+	value string
+	quote byte
+	line  int
+}
+
+// readDotenv reads and parses the dotenv file at path, naming it in any *SyntaxError.
+func readDotenv(path string) ([]entry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := parseDotenv(string(data))
+	if err != nil {
+		var syntaxErr *SyntaxError
+		if errors.As(err, &syntaxErr) {
+			syntaxErr.File = path
+		}
+		return nil, err
+	}
+	return entries, nil
+}
+
+// expandEntries expands references in entry values. Single-quoted and
+// backtick-quoted values are literal, but may still be referenced by others.
+func expandEntries(entries []entry) (map[string]string, error) {
+	vars := make(map[string]string, len(entries))
+	literal := map[string]bool{}
+	quoted := map[string]bool{}
+
+	for _, e := range entries {
+		vars[e.key] = e.value
+		literal[e.key] = e.quote == '\'' || e.quote == '`'
+		quoted[e.key] = e.quote == '"'
+	}
+
+	return std.expandAll(vars, literal, quoted)
+}
+
+// dotenvParser reads KEY=value assignments from the contents of a dotenv file.
+type dotenvParser struct {
+	data string
+	pos  int
+	line int
+}
+
+func parseDotenv(data string) ([]entry, error) {
+	p := &dotenvParser{data: data, line: 1}
+
+	var entries []entry
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return entries, nil
+		}
+
+		if p.peek() == '#' {
+			p.skipLine()
+			continue
+		}
+
+		parsed, err := p.entry()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, parsed)
+	}
+}
+
+func (p *dotenvParser) eof() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *dotenvParser) peek() byte {
+	return p.data[p.pos]
+}
+
+func (p *dotenvParser) advance() byte {
+	c := p.data[p.pos]
+	p.pos++
+	if c == '\n' {
+		p.line++
+	}
+	return c
+}
+
+func (p *dotenvParser) errorf(message string) error {
+	return &SyntaxError{Line: p.line, Msg: message}
+}
+
+// skipBlank skips whitespace, including newlines.
+func (p *dotenvParser) skipBlank() {
+	for !p.eof() && strings.IndexByte(" \t\r\n", p.peek()) >= 0 {
+		p.advance()
+	}
+}
+
+// skipSpace skips whitespace within the current line.
+func (p *dotenvParser) skipSpace() {
+	for !p.eof() && strings.IndexByte(" \t\r", p.peek()) >= 0 {
+		p.advance()
+	}
+}
+
+func (p *dotenvParser) skipLine() {
+	for !p.eof() && p.advance() != '\n' {
+	}
+}
+
+func (p *dotenvParser) atLineEnd() bool {
+	return p.eof() || p.peek() == '\n'
+}
+
+func (p *dotenvParser) entry() (entry, error) {
+	line := p.line
+	key := p.key()
+
+	if key == "export" && !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.skipSpace()
+		key = p.key()
+	}
+
+	if key == "" {
+		return entry{}, p.errorf("invalid variable name")
+	}
+
+	p.skipSpace()
+	if p.eof() || p.peek() != '=' {
+		return entry{}, p.errorf("expected '=' after " + key)
+	}
+	p.advance()
+	p.skipSpace()
+
+	if !p.eof() && strings.IndexByte("'\"`", p.peek()) >= 0 {
+		quote := p.advance()
+		value, err := p.quoted(quote)
+		if err != nil {
+			return entry{}, err
+		}
+
+		p.skipSpace()
+		if !p.atLineEnd() && p.peek() == '#' {
+			p.skipLine()
+		} else if !p.atLineEnd() {
+			return entry{}, p.errorf("unexpected characters after quoted value of " + key)
+		}
+
+		return entry{key: key, value: value, quote: quote, line: line}, nil
+	}
+
+	return entry{key: key, value: p.unquoted(), line: line}, nil
+}
+
+func (p *dotenvParser) key() string {
+	start := p.pos
+	for !p.eof() && isKeyChar(p.peek()) {
+		p.advance()
+	}
+	return p.data[start:p.pos]
+}
+
+func isKeyChar(c byte) bool {
+	return c == '_' || c == '.' || c == '-' ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// unquoted reads the rest of the line, dropping any inline comment,
+// which must be preceded by whitespace.
+func (p *dotenvParser) unquoted() string {
+	start := p.pos
+	for !p.atLineEnd() {
+		if p.peek() == '#' && (p.pos == start || strings.IndexByte(" \t", p.data[p.pos-1]) >= 0) {
+			value := p.data[start:p.pos]
+			p.skipLine()
+			return strings.TrimSpace(value)
+		}
+		p.advance()
+	}
+	return strings.TrimSpace(p.data[start:p.pos])
+}
+
+// quoted reads a value up to the closing quote, which may span multiple lines.
+// Escape sequences are only processed within double quotes,
+// where \$ becomes escapedDollar so expansion can treat the $ literally.
+func (p *dotenvParser) quoted(quote byte) (string, error) {
+	line := p.line
+	var value strings.Builder
+
+	for !p.eof() {
+		c := p.advance()
+
+		if c == quote {
+			return value.String(), nil
+		}
+
+		if c == '\\' && quote == '"' && !p.eof() {
+			value.WriteString(unescape(p.advance()))
+			continue
+		}
+
+		value.WriteByte(c)
+	}
+
+	p.line = line
+	return "", p.errorf("unterminated " + string(quote) + "-quoted value")
+}
+
+func unescape(c byte) string {
+	switch c {
+	case 'n':
+		return "\n"
+	case 'r':
+		return "\r"
+	case 't':
+		return "\t"
+	case '"', '\\':
+		return string(c)
+	case '$':
+		return string(escapedDollar)
+	case '\n':
+		return ""
+	default:
+		return "\\" + string(c)
+	}
+}
@@ -0,0 +1,239 @@
+package env
+
+import (
+	"strings"
+)
+
+// escapedDollar marks a $ escaped with a backslash in a double-quoted .env value.
+// The parser has already processed that value's backslashes, so a remaining \$ is a
+// literal backslash before a reference. Environment values cannot contain NUL.
+const escapedDollar = '\x00'
+
+// expander performs POSIX-style parameter expansion.
+// References are resolved against vars first, whose values are expanded on demand,
+// and then against lookup, whose values are used as-is.
+type expander struct {
+	vars    map[string]string
+	literal map[string]bool
+	// quoted lists the vars whose escapes the .env parser has already processed,
+	// marking escaped dollars with escapedDollar; marked is set while expanding one.
+	quoted   map[string]bool
+	marked   bool
+	lookup   func(string) (string, bool)
+	resolved map[string]string
+	stack    []string
+	// unresolved lists the plain references, without a default, to unset variables.
+	unresolved []string
+}
+
+func newExpander(vars map[string]string, literal map[string]bool, lookup func(string) (string, bool)) *expander {
+	return &expander{
+		vars:     vars,
+		literal:  literal,
+		lookup:   lookup,
+		resolved: map[string]string{},
+	}
+}
+
+// resolve returns the value of name, expanding it first when it is one of vars.
+// A variable referring to itself resolves through lookup, so PATH=${PATH}:/bin
+// extends the inherited value; any longer loop is reported as a cycle.
+func (x *expander) resolve(name string) (string, bool, error) {
+	if value, ok := x.resolved[name]; ok {
+		return value, true, nil
+	}
+
+	raw, ok := x.vars[name]
+	if !ok || (len(x.stack) > 0 && x.stack[len(x.stack)-1] == name) {
+		value, exists := x.lookup(name)
+		return value, exists, nil
+	}
+
+	if x.literal[name] {
+		return raw, true, nil
+	}
+
+	for i, pending := range x.stack {
+		if pending == name {
+			cycle := append(append([]string{}, x.stack[i:]...), name)
+			return "", false, &ExpandError{Name: name, Msg: "reference cycle " + strings.Join(cycle, " -> ")}
+		}
+	}
+
+	x.stack = append(x.stack, name)
+	outer := x.marked
+	x.marked = x.quoted[name]
+	value, err := x.expand(raw)
+	x.marked = outer
+	x.stack = x.stack[:len(x.stack)-1]
+	if err != nil {
+		return "", false, err
+	}
+
+	x.resolved[name] = value
+	return value, true, nil
+}
+
+// expand replaces every $NAME and ${...} reference in s. A backslash before $ escapes it,
+// or escapedDollar stands for a literal $ while expanding a quoted value.
+func (x *expander) expand(s string) (string, error) {
+	var result strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if x.marked && c == escapedDollar {
+			result.WriteByte('$')
+			continue
+		}
+
+		if !x.marked && c == '\\' && i+1 < len(s) && s[i+1] == '$' {
+			result.WriteByte('$')
+			i++
+			continue
+		}
+
+		if c != '$' || i+1 >= len(s) {
+			result.WriteByte(c)
+			continue
+		}
+
+		if s[i+1] == '{' {
+			end := matchingBrace(s, i+2, !x.marked)
+			if end < 0 {
+				return "", &ExpandError{Msg: "unterminated ${ in " + s}
+			}
+
+			value, err := x.braced(s[i+2 : end])
+			if err != nil {
+				return "", err
+			}
+
+			result.WriteString(value)
+			i = end
+			continue
+		}
+
+		name := variableName(s[i+1:])
+		if name == "" {
+			result.WriteByte(c)
+			continue
+		}
+
+		value, exists, err := x.resolve(name)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			x.unresolved = append(x.unresolved, name)
+		}
+
+		result.WriteString(value)
+		i += len(name)
+	}
+
+	return result.String(), nil
+}
+
+// braced expands the contents of a ${...} reference.
+func (x *expander) braced(content string) (string, error) {
+	name := variableName(content)
+	if name == "" {
+		return "", &ExpandError{Msg: "invalid reference ${" + content + "}"}
+	}
+
+	value, exists, err := x.resolve(name)
+	if err != nil {
+		return "", err
+	}
+
+	rest := content[len(name):]
+	if rest == "" {
+		if !exists {
+			x.unresolved = append(x.unresolved, name)
+		}
+		return value, nil
+	}
+
+	checkEmpty := rest[0] == ':'
+	if checkEmpty {
+		rest = rest[1:]
+	}
+
+	if rest == "" {
+		return "", &ExpandError{Name: name, Msg: "invalid reference ${" + content + "}"}
+	}
+
+	operator, word := rest[0], rest[1:]
+	set := exists && (!checkEmpty || value != "")
+
+	switch operator {
+	case '-':
+		if set {
+			return value, nil
+		}
+		return x.expand(word)
+	case '=':
+		if set {
+			return value, nil
+		}
+		assigned, err := x.expand(word)
+		if err != nil {
+			return "", err
+		}
+		x.resolved[name] = assigned
+		return assigned, nil
+	case '+':
+		if set {
+			return x.expand(word)
+		}
+		return "", nil
+	case '?':
+		if set {
+			return value, nil
+		}
+		message, err := x.expand(word)
+		if err != nil {
+			return "", err
+		}
+		if message == "" {
+			message = "not set"
+		}
+		return "", &ExpandError{Name: name, Msg: message}
+	default:
+		return "", &ExpandError{Name: name, Msg: "invalid reference ${" + content + "}"}
+	}
+}
+
+// matchingBrace returns the index of the } closing a ${ whose contents start at start,
+// skipping over nested ${...} references and, if escapes is set, backslash-escaped dollars.
+func matchingBrace(s string, start int, escapes bool) int {
+	depth := 1
+	for i := start; i < len(s); i++ {
+		switch {
+		case escapes && s[i] == '\\' && i+1 < len(s) && s[i+1] == '$':
+			i++
+		case s[i] == '$' && i+1 < len(s) && s[i+1] == '{':
+			depth++
+			i++
+		case s[i] == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// variableName returns the longest valid variable name at the start of s.
+func variableName(s string) string {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (i > 0 && c >= '0' && c <= '9') {
+			continue
+		}
+		return s[:i]
+	}
+	return s
+}
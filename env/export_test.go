@@ -0,0 +1,13 @@
+package env
+
+// ResetDeprecations forgets the aliases declared on the package-level Env and the
+// warnings sent for them, so tests calling Deprecate do not leak into later tests.
+func ResetDeprecations() {
+	fresh := newDeprecations()
+
+	d := std.deprecations
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.byKey, d.byTarget, d.warned = fresh.byKey, fresh.byTarget, fresh.warned
+}
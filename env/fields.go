@@ -0,0 +1,150 @@
+package env
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/sampson-golang/utilities/boolable"
+)
+
+// field is a single env-tagged struct field found while walking a config struct.
+type field struct {
+	name  string
+	keys  []string
+	value reflect.Value
+	tag   reflect.StructTag
+	// attach, when set, points the nil struct pointers leading to value at the
+	// structs allocated for them, so they are only allocated once a field is set.
+	attach func()
+}
+
+// set converts value into the field like Bind, attaching the structs that hold it.
+func (f field) set(value string) error {
+	if f.attach != nil {
+		f.attach()
+	}
+	return convertWith(value, f.value, f.splitter())
+}
+
+func (f field) defaultValue() (string, bool) {
+	return f.tag.Lookup("default")
+}
+
+func (f field) required() bool {
+	return boolable.From(f.tag.Get("required"))
+}
+
+func (f field) description() string {
+	return f.tag.Get("description")
+}
+
+func (f field) secret() bool {
+	return boolable.From(f.tag.Get("secret"))
+}
+
+// splitter returns DefaultSplitter, with separators overridden by
+// the field's `separator` and `pairSeparator` tags.
+func (f field) splitter() Splitter {
+	splitter := DefaultSplitter
+	if separator, ok := f.tag.Lookup("separator"); ok {
+		splitter.Separator = separator
+	}
+	if pair, ok := f.tag.Lookup("pairSeparator"); ok {
+		splitter.Pair = pair
+	}
+	return splitter
+}
+
+// fallbacks returns the arguments to pass to Lookup after the primary key:
+// the remaining keys followed by the literal default.
+func (f field) fallbacks() []string {
+	fallbacks := make([]string, 0, len(f.keys))
+	fallbacks = append(fallbacks, f.keys[1:]...)
+	def, _ := f.defaultValue()
+	return append(fallbacks, def)
+}
+
+// fields walks the struct pointed to by target and returns every env-tagged field.
+// Untagged struct fields are walked recursively, prepending their envPrefix tag
+// to the keys found inside them. Nil struct pointers are left nil until a field
+// inside them is set, and a struct type is not walked again inside itself.
+func fields(target interface{}) ([]field, error) {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("env: target must be a non-nil pointer to a struct")
+	}
+
+	walking := map[reflect.Type]bool{value.Elem().Type(): true}
+	return collect(value.Elem(), "", "", nil, walking), nil
+}
+
+func collect(value reflect.Value, prefix string, path string, attach func(), walking map[reflect.Type]bool) []field {
+	var result []field
+	valueType := value.Type()
+
+	for i := 0; i < value.NumField(); i++ {
+		structField := valueType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		name := path + structField.Name
+
+		tag, tagged := structField.Tag.Lookup("env")
+		if tag == "-" {
+			continue
+		}
+
+		if !tagged {
+			structType := indirectType(structField.Type)
+			if isLeaf(structField.Type) || walking[structType] {
+				continue
+			}
+
+			nestedAttach := attach
+			if fieldValue.Kind() == reflect.Ptr {
+				if fieldValue.IsNil() {
+					allocated := reflect.New(structField.Type.Elem())
+					nestedAttach = attachPointer(fieldValue, allocated, attach)
+					fieldValue = allocated
+				}
+				fieldValue = fieldValue.Elem()
+			}
+
+			walking[structType] = true
+			result = append(result, collect(fieldValue, prefix+structField.Tag.Get("envPrefix"), name+".", nestedAttach, walking)...)
+			delete(walking, structType)
+			continue
+		}
+
+		var keys []string
+		for _, key := range strings.Split(tag, ",") {
+			if key = strings.TrimSpace(key); key != "" {
+				keys = append(keys, prefix+key)
+			}
+		}
+
+		if len(keys) == 0 {
+			continue
+		}
+
+		result = append(result, field{name: name, keys: keys, value: fieldValue, tag: structField.Tag, attach: attach})
+	}
+
+	return result
+}
+
+// attachPointer returns an attach function that attaches the structs holding pointer,
+// then points it at allocated unless it has been set since.
+func attachPointer(pointer reflect.Value, allocated reflect.Value, parent func()) func() {
+	return func() {
+		if parent != nil {
+			parent()
+		}
+		if pointer.IsNil() {
+			pointer.Set(allocated)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package env
+
+import (
+	"sync"
+)
+
+// trail remembers the most recent resolution of every key an Env has read,
+// in the order the keys were first read.
+type trail struct {
+	mutex   sync.Mutex
+	order   []string
+	results map[string]Result
+	secrets map[string]bool
+}
+
+func newTrail() *trail {
+	return &trail{results: map[string]Result{}, secrets: map[string]bool{}}
+}
+
+// markSecret records that the values of keys must always be redacted.
+func (t *trail) markSecret(keys ...string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, key := range keys {
+		t.secrets[key] = true
+	}
+}
+
+func (t *trail) secret(keys []string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	for _, key := range keys {
+		if t.secrets[key] {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *trail) record(result Result) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	key := result.Keys[0]
+	if _, seen := t.results[key]; !seen {
+		t.order = append(t.order, key)
+	}
+	t.results[key] = result
+}
+
+func (t *trail) list() []Result {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	results := make([]Result, len(t.order))
+	for i, key := range t.order {
+		results[i] = t.results[key]
+	}
+	return results
+}